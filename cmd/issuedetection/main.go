@@ -103,7 +103,7 @@ func AnalyzeProfile(pathChannel chan string, errChan chan error, wg *sync.WaitGr
 			errChan <- err
 			continue
 		}
-		for _, o := range occurrence.Find(p, callTrees) {
+		for _, o := range occurrence.Find(p, callTrees, p.GetOptions().Detection) {
 			fmt.Println( // nolint
 				o.Event.Platform,
 				o.Event.ProjectID,
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"strconv"
 
@@ -16,7 +17,10 @@ import (
 	"google.golang.org/api/googleapi"
 
 	"github.com/getsentry/vroom/internal/chunk"
+	"github.com/getsentry/vroom/internal/httputil"
+	"github.com/getsentry/vroom/internal/jsonutil"
 	"github.com/getsentry/vroom/internal/storageutil"
+	"github.com/getsentry/vroom/internal/telemetry"
 )
 
 func (env *environment) postChunk(w http.ResponseWriter, r *http.Request) {
@@ -28,6 +32,10 @@ func (env *environment) postChunk(w http.ResponseWriter, r *http.Request) {
 	body, err := io.ReadAll(r.Body)
 	s.Finish()
 	if err != nil {
+		if httputil.IsBodyTooLarge(err) {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
 		if hub != nil {
 			hub.CaptureException(err)
 		}
@@ -49,6 +57,21 @@ func (env *environment) postChunk(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !httputil.OrganizationAllowed(ctx, c.OrganizationID) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	if ok, retryAfter := env.ingestLimiter.Allow(c.OrganizationID, c.ProjectID); !ok {
+		httputil.WriteRateLimited(w, telemetry.RateLimitIngest, retryAfter)
+		return
+	}
+
+	if err := c.Validate(env.profileLimits); err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
 	c.Normalize()
 
 	if hub != nil {
@@ -131,6 +154,13 @@ type postProfileFromChunkIDsResponse struct {
 	Chunk chunk.Chunk `json:"chunk"`
 }
 
+func (r postProfileFromChunkIDsResponse) WriteJSON(sw *jsonutil.StreamWriter) {
+	sw.BeginObject()
+	sw.Key("chunk")
+	r.Chunk.WriteJSON(sw)
+	sw.EndObject()
+}
+
 // This is more of a GET method, but since we're receiving a list of chunk IDs as part of a
 // body request, we use a POST method instead (similarly to the flamegraph endpoint).
 func (env *environment) postProfileFromChunkIDs(w http.ResponseWriter, r *http.Request) {
@@ -168,27 +198,73 @@ func (env *environment) postProfileFromChunkIDs(w http.ResponseWriter, r *http.R
 	}
 
 	hub.Scope().SetTag("num_chunks", fmt.Sprintf("%d", len(requestBody.ChunkIDs)))
-	s = sentry.StartSpan(ctx, "chunks.read")
+	chunk, err := env.mergeChunks(ctx, organizationID, projectID, requestBody)
+	if err != nil {
+		if errors.Is(err, storageutil.ErrTooManyReadJobs) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, storageutil.ErrSchedulerSaturated) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if errors.Is(err, storageutil.ErrObjectNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var e *googleapi.Error
+		if ok := errors.As(err, &e); ok {
+			hub.Scope().SetContext("Google Cloud Storage Error", map[string]interface{}{
+				"body":    e.Body,
+				"code":    e.Code,
+				"details": e.Details,
+				"message": e.Message,
+			})
+		}
+		hub.CaptureException(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	s = sentry.StartSpan(ctx, "json.marshal")
+	defer s.Finish()
+	writeJSONStream(w, r, postProfileFromChunkIDsResponse{Chunk: chunk}.WriteJSON)
+}
+
+// mergeChunks reads the chunks of a profiler and merges them into a single
+// one covering the requested range. It fails if any of them can't be read.
+func (env *environment) mergeChunks(
+	ctx context.Context,
+	organizationID uint64,
+	projectID uint64,
+	request postProfileFromChunkIDsRequest,
+) (chunk.Chunk, error) {
+	s := sentry.StartSpan(ctx, "chunks.read")
 	s.Description = "Read profile chunks from GCS"
 
-	results := make(chan storageutil.ReadJobResult, len(requestBody.ChunkIDs))
-	defer close(results)
-	// send a task to the workers pool for each chunk
-	for _, ID := range requestBody.ChunkIDs {
-		readJobs <- chunk.ReadJob{
+	results := make(chan storageutil.ReadJobResult, len(request.ChunkIDs))
+	batch := make([]storageutil.ReadJob, 0, cap(results))
+	// send a task to the read scheduler for each chunk
+	for _, ID := range request.ChunkIDs {
+		batch = append(batch, chunk.ReadJob{
 			Ctx:            ctx,
 			Storage:        env.storage,
 			OrganizationID: organizationID,
 			ProjectID:      projectID,
-			ProfilerID:     requestBody.ProfilerID,
+			ProfilerID:     request.ProfilerID,
 			ChunkID:        ID,
 			Result:         results,
-		}
+		})
+	}
+	err := readJobs.Submit(ctx, batch...)
+	if err != nil {
+		s.Finish()
+		return chunk.Chunk{}, err
 	}
 
-	chunks := make([]chunk.Chunk, 0, len(requestBody.ChunkIDs))
+	chunks := make([]chunk.Chunk, 0, len(request.ChunkIDs))
 	// read the output of each tasks
-	for i := 0; i < len(requestBody.ChunkIDs); i++ {
+	for i := 0; i < len(request.ChunkIDs); i++ {
 		res := <-results
 		result, ok := res.(chunk.ReadJobResult)
 		if !ok {
@@ -210,46 +286,18 @@ func (env *environment) postProfileFromChunkIDs(w http.ResponseWriter, r *http.R
 	}
 	s.Finish()
 	if err != nil {
-		if errors.Is(err, storageutil.ErrObjectNotFound) {
-			w.WriteHeader(http.StatusNotFound)
-			return
-		}
-		var e *googleapi.Error
-		if ok := errors.As(err, &e); ok {
-			hub.Scope().SetContext("Google Cloud Storage Error", map[string]interface{}{
-				"body":    e.Body,
-				"code":    e.Code,
-				"details": e.Details,
-				"message": e.Message,
-			})
-		}
-		hub.CaptureException(err)
-		w.WriteHeader(http.StatusInternalServerError)
-		return
+		return chunk.Chunk{}, err
 	}
 
 	s = sentry.StartSpan(ctx, "chunks.merge")
 	s.Description = "Merge profile chunks into a single one"
-	chunk, err := chunk.MergeChunks(chunks, requestBody.Start, requestBody.End)
-	s.Finish()
-	if err != nil {
-		hub.CaptureException(err)
-		w.WriteHeader(http.StatusInternalServerError)
-		return
-	}
-
-	s = sentry.StartSpan(ctx, "json.marshal")
 	defer s.Finish()
-	b, err := json.Marshal(postProfileFromChunkIDsResponse{Chunk: chunk})
-	if err != nil {
-		hub.CaptureException(err)
-		w.WriteHeader(http.StatusInternalServerError)
-		return
+	end := request.End
+	if end == 0 {
+		// without an end, keep every sample after the start
+		end = math.MaxUint64
 	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(b)
+	return chunk.MergeChunks(chunks, request.Start, end)
 }
 
 type (
@@ -6,12 +6,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"net/http/httptest"
 	"os"
 	"testing"
 
 	"github.com/getsentry/vroom/internal/chunk"
 	"github.com/getsentry/vroom/internal/frame"
+	"github.com/getsentry/vroom/internal/httputil"
 	"github.com/getsentry/vroom/internal/platform"
 	"github.com/getsentry/vroom/internal/storageutil"
 	"github.com/getsentry/vroom/internal/testutil"
@@ -150,3 +152,62 @@ func (k KafkaWriterMock) WriteMessages(_ context.Context, _ ...kafka.Message) er
 func (k KafkaWriterMock) Close() error {
 	return nil
 }
+
+func TestPostChunkRejections(t *testing.T) {
+	validChunk := chunk.Chunk{
+		ID:             uuid.New().String(),
+		ProfilerID:     uuid.New().String(),
+		Platform:       "python",
+		OrganizationID: 1,
+		ProjectID:      1,
+		Profile: chunk.Data{
+			Frames:  []frame.Frame{{Function: "a"}},
+			Stacks:  [][]int{{0}},
+			Samples: []chunk.Sample{{StackID: 0, ThreadID: "1", Timestamp: 1}},
+		},
+	}
+	invalidChunk := validChunk
+	invalidChunk.Profile.Stacks = [][]int{{1}}
+
+	tests := []struct {
+		name        string
+		chunk       chunk.Chunk
+		maxBodySize int64
+		want        int
+	}{
+		{
+			name:  "invalid stack",
+			chunk: invalidChunk,
+			want:  http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "body too large",
+			chunk:       validChunk,
+			maxBodySize: 10,
+			want:        http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			env := environment{
+				storage:         fileBlobBucket,
+				profilingWriter: KafkaWriterMock{},
+			}
+			jsonValue, err := json.Marshal(test.chunk)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := httptest.NewRequest("POST", "/", bytes.NewBuffer(jsonValue))
+			w := httptest.NewRecorder()
+			httputil.LimitBody(test.maxBodySize, http.HandlerFunc(env.postChunk)).ServeHTTP(w, req)
+			if w.Code != test.want {
+				t.Fatalf("expected status code %d, got %d", test.want, w.Code)
+			}
+			if w.Body.Len() == 0 {
+				t.Fatal("expected a reason in the body")
+			}
+		})
+	}
+}
@@ -1,13 +1,56 @@
 package main
 
+import "time"
+
 type (
 	ServiceConfig struct {
 		Environment    string `env:"SENTRY_ENVIRONMENT" env-default:"development"`
 		Port           int    `env:"PORT"               env-default:"8085"`
 		WorkerPoolSize int    `env:"WORKER_POOL_SIZE"               env-default:"100"`
 
+		// ReadQueueSize is the number of storage reads waiting for a worker
+		// before requests are rejected, requests reading more objects than
+		// that are rejected as bad requests. ReadMaxConcurrencyPerRequest caps the
+		// workers used by a single request.
+		ReadQueueSize                int `env:"READ_QUEUE_SIZE"                  env-default:"5000"`
+		ReadMaxConcurrencyPerRequest int `env:"READ_MAX_CONCURRENCY_PER_REQUEST" env-default:"25"`
+
+		// Requests per second and burst allowed for each project to ingest
+		// profiles and chunks and for each organization on the routes reading
+		// many objects. A zero rate disables the limit. Per organization
+		// limits can be set in a JSON file, see ratelimit.Overrides.
+		IngestRateLimit        float64 `env:"INGEST_RATE_LIMIT"`
+		IngestRateLimitBurst   int     `env:"INGEST_RATE_LIMIT_BURST"   env-default:"100"`
+		ReadRateLimit          float64 `env:"READ_RATE_LIMIT"`
+		ReadRateLimitBurst     int     `env:"READ_RATE_LIMIT_BURST"     env-default:"10"`
+		RateLimitOverridesPath string  `env:"RATE_LIMIT_OVERRIDES_PATH"`
+
+		// MaxBodySize limits the size of request bodies as sent and
+		// MaxDecompressedBodySize once decompressed. Larger requests are
+		// rejected with a 413.
+		MaxBodySize             int64 `env:"MAX_BODY_SIZE"              env-default:"52428800"`
+		MaxDecompressedBodySize int64 `env:"MAX_DECOMPRESSED_BODY_SIZE" env-default:"209715200"`
+
+		// Profiles and chunks with more samples, frames or threads are
+		// rejected with a 422.
+		MaxProfileSamples int `env:"MAX_PROFILE_SAMPLES" env-default:"1000000"`
+		MaxProfileFrames  int `env:"MAX_PROFILE_FRAMES"  env-default:"500000"`
+		MaxProfileThreads int `env:"MAX_PROFILE_THREADS" env-default:"1000"`
+
+		// GRPCPort serves the read endpoints over gRPC when set, see
+		// pkg/vroompb.
+		GRPCPort int `env:"GRPC_PORT"`
+
+		// MetricsPort exposes Prometheus metrics on /metrics when set.
+		MetricsPort int `env:"METRICS_PORT"`
+
 		SentryDSN string `env:"SENTRY_DSN"`
 
+		// AuthKeys is a JSON list of keys requests have to be signed with,
+		// requests aren't authenticated when empty. See httputil.AuthKey.
+		AuthKeys         string        `env:"AUTH_KEYS"`
+		AuthMaxClockSkew time.Duration `env:"AUTH_MAX_CLOCK_SKEW" env-default:"5m"`
+
 		OccurrencesKafkaBrokers []string `env:"SENTRY_KAFKA_BROKERS_OCCURRENCES" env-default:"localhost:9092"`
 		ProfilingKafkaBrokers   []string `env:"SENTRY_KAFKA_BROKERS_PROFILING" env-default:"localhost:9092"`
 		SpansKafkaBrokers       []string `env:"SENTRY_KAFKA_BROKERS_SPANS" env-default:"localhost:9092"`
@@ -23,5 +66,19 @@ type (
 		BucketURL string `env:"SENTRY_BUCKET_PROFILES" env-default:"file://./test/gcs/sentry-profiles"`
 
 		KafkaClientId string `env:"SENTRY_KAFKA_CLIENT_ID" env-default:"vroom"`
+
+		// DetectionOverridesPath points to a JSON file mapping project IDs to
+		// issue detection overrides.
+		DetectionOverridesPath string `env:"SENTRY_DETECTION_OVERRIDES_PATH"`
+
+		// RegressionDetectionInterval enables the built-in function regression
+		// detection when set and controls how often it runs.
+		RegressionDetectionInterval time.Duration `env:"SENTRY_REGRESSION_DETECTION_INTERVAL"`
+
+		// Only the first OccurrencesDedupLimit occurrences with the same
+		// fingerprint and project are sent per OccurrencesDedupWindow.
+		// Deduplication is disabled by default, with a limit of 0.
+		OccurrencesDedupLimit  int64         `env:"SENTRY_OCCURRENCES_DEDUP_LIMIT"`
+		OccurrencesDedupWindow time.Duration `env:"SENTRY_OCCURRENCES_DEDUP_WINDOW" env-default:"1m"`
 	}
 )
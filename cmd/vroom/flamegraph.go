@@ -1,9 +1,12 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 	"time"
 
@@ -12,6 +15,8 @@ import (
 
 	"github.com/getsentry/vroom/internal/flamegraph"
 	"github.com/getsentry/vroom/internal/metrics"
+	"github.com/getsentry/vroom/internal/speedscope"
+	"github.com/getsentry/vroom/internal/storageutil"
 	"github.com/getsentry/vroom/internal/utils"
 )
 
@@ -20,6 +25,42 @@ const (
 	timeout       time.Duration = time.Second * 5
 )
 
+var errInvalidFlamegraphOptions = errors.New("flamegraph: invalid options")
+
+// flamegraphOptionsFromQuery reads the options shared by all flamegraph routes.
+func flamegraphOptionsFromQuery(qs url.Values) (flamegraph.Options, error) {
+	options := flamegraph.DefaultOptions
+	if rawInverted := qs.Get("inverted"); rawInverted != "" {
+		inverted, err := strconv.ParseBool(rawInverted)
+		if err != nil {
+			return options, errInvalidFlamegraphOptions
+		}
+		options.Inverted = inverted
+	}
+	if rawMinSampleCount := qs.Get("min_sample_count"); rawMinSampleCount != "" {
+		minSampleCount, err := strconv.Atoi(rawMinSampleCount)
+		if err != nil || minSampleCount < 0 {
+			return options, errInvalidFlamegraphOptions
+		}
+		options.MinSampleCount = minSampleCount
+	}
+	if rawMaxDepth := qs.Get("max_depth"); rawMaxDepth != "" {
+		maxDepth, err := strconv.Atoi(rawMaxDepth)
+		if err != nil || maxDepth <= 0 {
+			return options, errInvalidFlamegraphOptions
+		}
+		options.MaxDepth = maxDepth
+	}
+	if rawMinDurationNS := qs.Get("min_duration_ns"); rawMinDurationNS != "" {
+		minDurationNS, err := strconv.ParseUint(rawMinDurationNS, 10, 64)
+		if err != nil {
+			return options, errInvalidFlamegraphOptions
+		}
+		options.MinDurationNS = minDurationNS
+	}
+	return options, nil
+}
+
 type postFlamegraphFromProfileIDs struct {
 	ProfileIDs []string `json:"profile_ids"`
 	// Spans is optional. If not nil,
@@ -27,6 +68,8 @@ type postFlamegraphFromProfileIDs struct {
 	// list of span intervals for the
 	// profile ProfileIDs[i]
 	Spans *[][]utils.Interval `json:"spans,omitempty"`
+	// Transforms are applied to call trees before they're aggregated.
+	Transforms []flamegraph.Transform `json:"transforms,omitempty"`
 }
 
 func (env *environment) postFlamegraphFromProfileIDs(w http.ResponseWriter, r *http.Request) {
@@ -52,6 +95,12 @@ func (env *environment) postFlamegraphFromProfileIDs(w http.ResponseWriter, r *h
 	}
 	hub.Scope().SetTag("project_id", rawProjectID)
 
+	options, err := flamegraphOptionsFromQuery(r.URL.Query())
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
 	var profiles postFlamegraphFromProfileIDs
 	s := sentry.StartSpan(ctx, "processing")
 	s.Description = "Decoding data"
@@ -63,6 +112,12 @@ func (env *environment) postFlamegraphFromProfileIDs(w http.ResponseWriter, r *h
 		return
 	}
 
+	options.Transforms = profiles.Transforms
+	if err := options.Validate(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
 	if profiles.Spans != nil && (len(*profiles.Spans) != len(profiles.ProfileIDs)) {
 		hub.CaptureException(errors.New("flamegraph: lengths of profile_ids and spans don't match"))
 		w.WriteHeader(http.StatusBadRequest)
@@ -72,7 +127,7 @@ func (env *environment) postFlamegraphFromProfileIDs(w http.ResponseWriter, r *h
 	numWorkers := getFlamegraphNumWorkers(len(profiles.ProfileIDs), minNumWorkers)
 
 	s = sentry.StartSpan(ctx, "processing")
-	speedscope, err := flamegraph.GetFlamegraphFromProfiles(ctx, env.storage, organizationID, projectID, profiles.ProfileIDs, profiles.Spans, numWorkers, timeout)
+	speedscope, err := flamegraph.GetFlamegraphFromProfiles(ctx, env.storage, organizationID, projectID, profiles.ProfileIDs, profiles.Spans, numWorkers, timeout, options)
 	if err != nil {
 		s.Finish()
 		hub.CaptureException(err)
@@ -85,20 +140,12 @@ func (env *environment) postFlamegraphFromProfileIDs(w http.ResponseWriter, r *h
 
 	s = sentry.StartSpan(ctx, "json.marshal")
 	defer s.Finish()
-	b, err := json.Marshal(speedscope)
-	if err != nil {
-		hub.CaptureException(err)
-		w.WriteHeader(http.StatusInternalServerError)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(b)
+	writeFlamegraph(w, r, speedscope)
 }
 
 type postFlamegraphFromChunksMetadataBody struct {
 	ChunksMetadata []flamegraph.ChunkMetadata `json:"chunks_metadata"`
+	Transforms     []flamegraph.Transform     `json:"transforms,omitempty"`
 }
 
 func (env *environment) postFlamegraphFromChunksMetadata(w http.ResponseWriter, r *http.Request) {
@@ -128,6 +175,12 @@ func (env *environment) postFlamegraphFromChunksMetadata(w http.ResponseWriter,
 		hub.Scope().SetTag("project_id", rawProjectID)
 	}
 
+	options, err := flamegraphOptionsFromQuery(r.URL.Query())
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
 	var body postFlamegraphFromChunksMetadataBody
 	s := sentry.StartSpan(ctx, "processing")
 	s.Description = "Decoding data"
@@ -141,10 +194,24 @@ func (env *environment) postFlamegraphFromChunksMetadata(w http.ResponseWriter,
 		return
 	}
 
+	options.Transforms = body.Transforms
+	if err := options.Validate(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
 	s = sentry.StartSpan(ctx, "processing")
-	speedscope, err := flamegraph.GetFlamegraphFromChunks(ctx, organizationID, projectID, env.storage, body.ChunksMetadata, readJobs)
+	speedscope, err := flamegraph.GetFlamegraphFromChunks(ctx, organizationID, projectID, env.storage, body.ChunksMetadata, readJobs, options)
 	s.Finish()
 	if err != nil {
+		if errors.Is(err, storageutil.ErrTooManyReadJobs) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, storageutil.ErrSchedulerSaturated) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
 		if hub != nil {
 			hub.CaptureException(err)
 		}
@@ -158,18 +225,7 @@ func (env *environment) postFlamegraphFromChunksMetadata(w http.ResponseWriter,
 
 	s = sentry.StartSpan(ctx, "json.marshal")
 	defer s.Finish()
-	b, err := json.Marshal(speedscope)
-	if err != nil {
-		if hub != nil {
-			hub.CaptureException(err)
-		}
-		w.WriteHeader(http.StatusInternalServerError)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(b)
+	writeFlamegraph(w, r, speedscope)
 }
 
 type (
@@ -177,6 +233,7 @@ type (
 		Transaction     []utils.TransactionProfileCandidate `json:"transaction"`
 		Continuous      []utils.ContinuousProfileCandidate  `json:"continuous"`
 		GenerateMetrics bool                                `json:"generate_metrics"`
+		Transforms      []flamegraph.Transform              `json:"transforms,omitempty"`
 	}
 )
 
@@ -196,6 +253,12 @@ func (env *environment) postFlamegraph(w http.ResponseWriter, r *http.Request) {
 
 	hub.Scope().SetTag("organization_id", rawOrganizationID)
 
+	options, err := flamegraphOptionsFromQuery(r.URL.Query())
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
 	var body postFlamegraphBody
 	s := sentry.StartSpan(ctx, "processing")
 	s.Description = "Decoding data"
@@ -209,13 +272,53 @@ func (env *environment) postFlamegraph(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s = sentry.StartSpan(ctx, "processing")
+	speedscope, err := env.aggregateFlamegraph(ctx, organizationID, body, options)
+	if err != nil {
+		if errors.Is(err, errInvalidFlamegraphOptions) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, storageutil.ErrTooManyReadJobs) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, storageutil.ErrSchedulerSaturated) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if hub != nil {
+			hub.CaptureException(err)
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	s = sentry.StartSpan(ctx, "json.marshal")
+	defer s.Finish()
+	writeFlamegraph(w, r, speedscope)
+}
+
+// aggregateFlamegraph aggregates the profiles of the candidates into a
+// flamegraph, with the metrics of their functions if requested.
+func (env *environment) aggregateFlamegraph(
+	ctx context.Context,
+	organizationID uint64,
+	body postFlamegraphBody,
+	options flamegraph.Options,
+) (speedscope.Output, error) {
+	options.Transforms = body.Transforms
+	if err := options.Validate(); err != nil {
+		return speedscope.Output{}, fmt.Errorf("%w: %v", errInvalidFlamegraphOptions, err)
+	}
+
+	s := sentry.StartSpan(ctx, "processing")
+	defer s.Finish()
 	var ma *metrics.Aggregator
 	if body.GenerateMetrics {
 		agg := metrics.NewAggregator(maxUniqueFunctionsPerProfile, 5)
 		ma = &agg
 	}
-	speedscope, err := flamegraph.GetFlamegraphFromCandidates(
+	return flamegraph.GetFlamegraphFromCandidates(
 		ctx,
 		env.storage,
 		organizationID,
@@ -223,28 +326,6 @@ func (env *environment) postFlamegraph(w http.ResponseWriter, r *http.Request) {
 		body.Continuous,
 		readJobs,
 		ma,
+		options,
 	)
-	s.Finish()
-	if err != nil {
-		if hub != nil {
-			hub.CaptureException(err)
-		}
-		w.WriteHeader(http.StatusInternalServerError)
-		return
-	}
-
-	s = sentry.StartSpan(ctx, "json.marshal")
-	defer s.Finish()
-	b, err := json.Marshal(speedscope)
-	if err != nil {
-		if hub != nil {
-			hub.CaptureException(err)
-		}
-		w.WriteHeader(http.StatusInternalServerError)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(b)
 }
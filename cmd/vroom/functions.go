@@ -0,0 +1,160 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/getsentry/vroom/internal/metrics"
+	"github.com/getsentry/vroom/internal/nodetree"
+)
+
+type (
+	profileFunction struct {
+		Fingerprint uint32 `json:"fingerprint"`
+		Function    string `json:"function"`
+		Package     string `json:"package"`
+		InApp       bool   `json:"in_app"`
+		SelfTimeNS  uint64 `json:"self_time_ns"`
+		TotalTimeNS uint64 `json:"total_time_ns"`
+		SampleCount int    `json:"sample_count"`
+	}
+
+	getProfileFunctionsResponse struct {
+		Functions []profileFunction `json:"functions"`
+	}
+
+	profileFunctionsOptions struct {
+		// InApp only keeps application or system functions when set.
+		InApp  *bool
+		Limit  int
+		SortBy string
+	}
+)
+
+const (
+	sortBySelfTime    = "self_time"
+	sortByTotalTime   = "total_time"
+	sortBySampleCount = "sample_count"
+
+	defaultProfileFunctionsLimit = 100
+)
+
+var errInvalidProfileFunctionsOptions = errors.New("invalid options")
+
+func (env *environment) getProfileFunctions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	hub := sentry.GetHubFromContext(ctx)
+	options, err := parseProfileFunctionsOptions(r.URL.Query())
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	p, ok := env.readProfile(w, r)
+	if !ok {
+		return
+	}
+
+	s := sentry.StartSpan(ctx, "processing")
+	s.Description = "Generate call trees"
+	callTrees, err := p.CallTrees()
+	s.Finish()
+	if err != nil {
+		hub.CaptureException(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	s = sentry.StartSpan(ctx, "processing")
+	s.Description = "Extract functions"
+	functions := summarizeProfileFunctions(
+		metrics.ExtractFunctionsFromCallTrees(callTrees),
+		options,
+	)
+	s.Finish()
+
+	s = sentry.StartSpan(ctx, "json.marshal")
+	defer s.Finish()
+	b, err := json.Marshal(getProfileFunctionsResponse{Functions: functions})
+	if err != nil {
+		hub.CaptureException(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=3600, immutable")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(b)
+}
+
+func parseProfileFunctionsOptions(qs url.Values) (profileFunctionsOptions, error) {
+	options := profileFunctionsOptions{
+		Limit:  defaultProfileFunctionsLimit,
+		SortBy: sortBySelfTime,
+	}
+	if sortBy := qs.Get("sort"); sortBy != "" {
+		switch sortBy {
+		case sortBySelfTime, sortByTotalTime, sortBySampleCount:
+			options.SortBy = sortBy
+		default:
+			return options, errInvalidProfileFunctionsOptions
+		}
+	}
+	if rawInApp := qs.Get("in_app"); rawInApp != "" {
+		inApp, err := strconv.ParseBool(rawInApp)
+		if err != nil {
+			return options, errInvalidProfileFunctionsOptions
+		}
+		options.InApp = &inApp
+	}
+	if rawLimit := qs.Get("limit"); rawLimit != "" {
+		limit, err := strconv.Atoi(rawLimit)
+		if err != nil || limit <= 0 {
+			return options, errInvalidProfileFunctionsOptions
+		}
+		options.Limit = limit
+	}
+	return options, nil
+}
+
+func summarizeProfileFunctions(
+	functions []nodetree.CallTreeFunction,
+	options profileFunctionsOptions,
+) []profileFunction {
+	summary := make([]profileFunction, 0, len(functions))
+	for _, f := range functions {
+		if options.InApp != nil && f.InApp != *options.InApp {
+			continue
+		}
+		summary = append(summary, profileFunction{
+			Fingerprint: f.Fingerprint,
+			Function:    f.Function,
+			Package:     f.Package,
+			InApp:       f.InApp,
+			SelfTimeNS:  f.SumSelfTimeNS,
+			TotalTimeNS: f.TotalTimeNS,
+			SampleCount: f.SampleCount,
+		})
+	}
+	sort.SliceStable(summary, func(i, j int) bool {
+		switch options.SortBy {
+		case sortByTotalTime:
+			return summary[i].TotalTimeNS > summary[j].TotalTimeNS
+		case sortBySampleCount:
+			return summary[i].SampleCount > summary[j].SampleCount
+		default:
+			return summary[i].SelfTimeNS > summary[j].SelfTimeNS
+		}
+	})
+	if len(summary) > options.Limit {
+		summary = summary[:options.Limit]
+	}
+	return summary
+}
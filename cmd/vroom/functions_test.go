@@ -0,0 +1,90 @@
+package main
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/testutil"
+)
+
+func TestSummarizeProfileFunctions(t *testing.T) {
+	inApp := true
+	functions := []nodetree.CallTreeFunction{
+		{Fingerprint: 1, Function: "a", InApp: true, SumSelfTimeNS: 30, TotalTimeNS: 30, SampleCount: 2},
+		{Fingerprint: 2, Function: "b", InApp: false, SumSelfTimeNS: 20, TotalTimeNS: 20, SampleCount: 5},
+		{Fingerprint: 3, Function: "c", InApp: true, SumSelfTimeNS: 10, TotalTimeNS: 100, SampleCount: 3},
+		{Fingerprint: 4, Function: "d", InApp: true, TotalTimeNS: 200},
+	}
+	tests := []struct {
+		name    string
+		options profileFunctionsOptions
+		want    []string
+	}{
+		{
+			name:    "sort by self time",
+			options: profileFunctionsOptions{Limit: 10, SortBy: sortBySelfTime},
+			want:    []string{"a", "b", "c", "d"},
+		},
+		{
+			name:    "sort by total time",
+			options: profileFunctionsOptions{Limit: 10, SortBy: sortByTotalTime},
+			want:    []string{"d", "c", "a", "b"},
+		},
+		{
+			name:    "sort by sample count",
+			options: profileFunctionsOptions{Limit: 10, SortBy: sortBySampleCount},
+			want:    []string{"b", "c", "a", "d"},
+		},
+		{
+			name:    "in app only",
+			options: profileFunctionsOptions{InApp: &inApp, Limit: 10, SortBy: sortBySelfTime},
+			want:    []string{"a", "c", "d"},
+		},
+		{
+			name:    "limit",
+			options: profileFunctionsOptions{Limit: 1, SortBy: sortByTotalTime},
+			want:    []string{"d"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			summary := summarizeProfileFunctions(functions, test.options)
+			got := make([]string, 0, len(summary))
+			for _, f := range summary {
+				got = append(got, f.Function)
+			}
+			if diff := testutil.Diff(got, test.want); diff != "" {
+				t.Fatalf("Result mismatch: got - want +\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseProfileFunctionsOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		wantErr bool
+	}{
+		{name: "defaults", query: ""},
+		{name: "all options", query: "sort=total_time&in_app=false&limit=5"},
+		{name: "unknown sort", query: "sort=name", wantErr: true},
+		{name: "invalid in_app", query: "in_app=maybe", wantErr: true},
+		{name: "negative limit", query: "limit=-1", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			qs, err := url.ParseQuery(test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = parseProfileFunctionsOptions(qs)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error: %v, got: %v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/getsentry/vroom/internal/flamegraph"
+	"github.com/getsentry/vroom/internal/grpcutil"
+	"github.com/getsentry/vroom/internal/httputil"
+	"github.com/getsentry/vroom/internal/metrics"
+	"github.com/getsentry/vroom/internal/occurrence"
+	"github.com/getsentry/vroom/internal/profile"
+	"github.com/getsentry/vroom/internal/storageutil"
+	"github.com/getsentry/vroom/internal/utils"
+	"github.com/getsentry/vroom/pkg/vroompb"
+)
+
+// flamegraphSamplesPerPart bounds the size of the messages of a streamed
+// flamegraph.
+const flamegraphSamplesPerPart = 10_000
+
+// grpcServer serves the read endpoints over gRPC with the same environment
+// and storage read pool as the HTTP ones.
+type grpcServer struct {
+	vroompb.UnimplementedVroomServer
+
+	env *environment
+}
+
+func (e *environment) newGRPCServer() *grpc.Server {
+	// Same priorities and rate limits as the equivalent HTTP routes.
+	readPriorities := map[string]storageutil.ReadOptions{
+		vroompb.Vroom_GetChunksProfile_FullMethodName: {
+			Priority:       storageutil.PriorityHigh,
+			MaxConcurrency: e.config.ReadMaxConcurrencyPerRequest,
+		},
+		vroompb.Vroom_GetFlamegraph_FullMethodName: {
+			Priority:       storageutil.PriorityLow,
+			MaxConcurrency: e.config.ReadMaxConcurrencyPerRequest,
+		},
+		vroompb.Vroom_GetMetrics_FullMethodName: {
+			Priority:       storageutil.PriorityLow,
+			MaxConcurrency: e.config.ReadMaxConcurrencyPerRequest,
+		},
+	}
+	rateLimited := make(map[string]struct{}, len(readPriorities))
+	for method := range readPriorities {
+		rateLimited[method] = struct{}{}
+	}
+
+	var options []grpc.ServerOption
+	if e.config.MaxBodySize > 0 {
+		options = append(options, grpc.MaxRecvMsgSize(int(e.config.MaxBodySize)))
+	}
+	var interceptors []grpcutil.Interceptor
+	if e.authenticator != nil {
+		codec, authenticate := grpcutil.Authenticate(e.authenticator)
+		options = append(options, codec)
+		interceptors = append(interceptors, authenticate)
+	}
+	interceptors = append(
+		interceptors,
+		grpcutil.RateLimit(e.readLimiter, rateLimited),
+		grpcutil.WithReadOptions(readPriorities, storageutil.ReadOptions{
+			Priority:       storageutil.PriorityNormal,
+			MaxConcurrency: e.config.ReadMaxConcurrencyPerRequest,
+		}),
+	)
+
+	traceUnary, traceStream := grpcutil.Trace()
+	options = append(
+		options,
+		grpc.ChainUnaryInterceptor(traceUnary, grpcutil.UnaryServerInterceptor(interceptors...)),
+		grpc.ChainStreamInterceptor(traceStream, grpcutil.StreamServerInterceptor(interceptors...)),
+	)
+	server := grpc.NewServer(options...)
+	vroompb.RegisterVroomServer(server, &grpcServer{env: e})
+	return server
+}
+
+func (s *grpcServer) GetProfile(ctx context.Context, req *vroompb.GetProfileRequest) (*vroompb.Speedscope, error) {
+	if _, err := uuid.Parse(req.GetProfileId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "profile_id must be a UUID")
+	}
+
+	span := sentry.StartSpan(ctx, "profile.read")
+	span.Description = "Read profile from GCS"
+	var p profile.Profile
+	err := storageutil.UnmarshalCompressed(
+		ctx,
+		s.env.storage,
+		profile.StoragePath(req.GetOrganizationId(), req.GetProjectId(), req.GetProfileId()),
+		&p,
+	)
+	span.Finish()
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	o, err := p.Speedscope()
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+	pb, err := o.Proto()
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+	return pb, nil
+}
+
+func (s *grpcServer) GetChunksProfile(
+	ctx context.Context,
+	req *vroompb.GetChunksProfileRequest,
+) (*vroompb.Chunk, error) {
+	if len(req.GetChunkIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "chunk_ids is required")
+	}
+	c, err := s.env.mergeChunks(ctx, req.GetOrganizationId(), req.GetProjectId(), postProfileFromChunkIDsRequest{
+		ProfilerID: req.GetProfilerId(),
+		ChunkIDs:   req.GetChunkIds(),
+		Start:      req.GetStart(),
+		End:        req.GetEnd(),
+	})
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+	pb, err := c.Proto()
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+	return pb, nil
+}
+
+func (s *grpcServer) GetFlamegraph(req *vroompb.GetFlamegraphRequest, stream vroompb.Vroom_GetFlamegraphServer) error {
+	ctx := stream.Context()
+	options := flamegraph.DefaultOptions
+	if o := req.GetOptions(); o != nil {
+		if o.Inverted != nil {
+			options.Inverted = o.GetInverted()
+		}
+		if o.MinSampleCount != nil {
+			options.MinSampleCount = int(o.GetMinSampleCount())
+		}
+		if o.MaxDepth != nil {
+			if o.GetMaxDepth() == 0 {
+				return status.Error(codes.InvalidArgument, "max_depth must be greater than 0")
+			}
+			options.MaxDepth = int(o.GetMaxDepth())
+		}
+		if o.MinDurationNs != nil {
+			options.MinDurationNS = o.GetMinDurationNs()
+		}
+	}
+	body := postFlamegraphBody{
+		Transaction:     transactionCandidates(req.GetTransaction()),
+		Continuous:      continuousCandidates(req.GetContinuous()),
+		GenerateMetrics: req.GetGenerateMetrics(),
+	}
+	for _, t := range req.GetTransforms() {
+		body.Transforms = append(body.Transforms, flamegraph.Transform{
+			Type:     flamegraph.TransformType(t.GetType()),
+			Function: t.GetFunction(),
+			Package:  t.GetPackage(),
+			Pattern:  t.GetPattern(),
+		})
+	}
+
+	output, err := s.env.aggregateFlamegraph(ctx, req.GetOrganizationId(), body, options)
+	if err != nil {
+		return grpcError(ctx, err)
+	}
+
+	span := sentry.StartSpan(ctx, "proto.marshal")
+	defer span.Finish()
+	return output.StreamFlamegraph(flamegraphSamplesPerPart, stream.Send)
+}
+
+func (s *grpcServer) GetMetrics(
+	ctx context.Context,
+	req *vroompb.GetMetricsRequest,
+) (*vroompb.GetMetricsResponse, error) {
+	body := postMetricsRequestBody{
+		Transaction:     transactionCandidates(req.GetTransaction()),
+		Continuous:      continuousCandidates(req.GetContinuous()),
+		Quantiles:       req.GetQuantiles(),
+		IncludeSketches: req.GetIncludeSketches(),
+		RankBy:          req.GetRankBy(),
+		Interval:        req.GetInterval(),
+	}
+	for _, d := range req.GetGroupBy() {
+		body.GroupBy = append(body.GroupBy, metrics.Dimension(d))
+	}
+	response, err := s.env.functionsMetrics(ctx, req.GetOrganizationId(), body)
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+	pb := &vroompb.GetMetricsResponse{
+		FunctionsMetrics: utils.FunctionMetricsProto(response.FunctionsMetrics),
+	}
+	for _, g := range response.Groups {
+		pb.Groups = append(pb.Groups, g.Proto())
+	}
+	return pb, nil
+}
+
+func (s *grpcServer) ProcessRegressed(
+	ctx context.Context,
+	req *vroompb.ProcessRegressedRequest,
+) (*vroompb.ProcessRegressedResponse, error) {
+	regressedFunctions := make([]occurrence.RegressedFunction, 0, len(req.GetRegressedFunctions()))
+	for _, f := range req.GetRegressedFunctions() {
+		if !httputil.OrganizationAllowed(ctx, f.GetOrganizationId()) {
+			return nil, status.Error(codes.PermissionDenied, "organization not allowed for key")
+		}
+		regressedFunctions = append(regressedFunctions, occurrence.RegressedFunction{
+			OrganizationID:           f.GetOrganizationId(),
+			ProjectID:                f.GetProjectId(),
+			ProfileID:                f.GetProfileId(),
+			Fingerprint:              f.GetFingerprint(),
+			AbsolutePercentageChange: f.GetAbsolutePercentageChange(),
+			AggregateRange1:          f.GetAggregateRange_1(),
+			AggregateRange2:          f.GetAggregateRange_2(),
+			Breakpoint:               f.GetBreakpoint(),
+			TrendDifference:          f.GetTrendDifference(),
+			TrendPercentage:          f.GetTrendPercentage(),
+			UnweightedPValue:         f.GetUnweightedPValue(),
+			UnweightedTValue:         f.GetUnweightedTValue(),
+		})
+	}
+
+	emitted, err := s.env.processRegressedFunctions(ctx, regressedFunctions)
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	pb := &vroompb.ProcessRegressedResponse{
+		Occurrences: uint32(len(emitted)),
+		Emitted:     make([]*vroompb.RegressedFunction, 0, len(emitted)),
+	}
+	for _, f := range emitted {
+		pb.Emitted = append(pb.Emitted, &vroompb.RegressedFunction{
+			OrganizationId:           f.OrganizationID,
+			ProjectId:                f.ProjectID,
+			ProfileId:                f.ProfileID,
+			Fingerprint:              f.Fingerprint,
+			AbsolutePercentageChange: f.AbsolutePercentageChange,
+			AggregateRange_1:         f.AggregateRange1,
+			AggregateRange_2:         f.AggregateRange2,
+			Breakpoint:               f.Breakpoint,
+			TrendDifference:          f.TrendDifference,
+			TrendPercentage:          f.TrendPercentage,
+			UnweightedPValue:         f.UnweightedPValue,
+			UnweightedTValue:         f.UnweightedTValue,
+		})
+	}
+	return pb, nil
+}
+
+// grpcError maps an error to the status code its HTTP counterpart would
+// return, unexpected errors are reported.
+func grpcError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, errInvalidFlamegraphOptions),
+		errors.Is(err, errInvalidMetricsRequest),
+		errors.Is(err, storageutil.ErrTooManyReadJobs):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, storageutil.ErrObjectNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, storageutil.ErrSchedulerSaturated):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	if hub := sentry.GetHubFromContext(ctx); hub != nil {
+		hub.CaptureException(err)
+	}
+	return status.Error(codes.Internal, "internal error")
+}
+
+func transactionCandidates(pb []*vroompb.TransactionProfileCandidate) []utils.TransactionProfileCandidate {
+	candidates := make([]utils.TransactionProfileCandidate, 0, len(pb))
+	for _, c := range pb {
+		candidates = append(candidates, utils.TransactionProfileCandidate{
+			ProjectID: c.GetProjectId(),
+			ProfileID: c.GetProfileId(),
+		})
+	}
+	return candidates
+}
+
+func continuousCandidates(pb []*vroompb.ContinuousProfileCandidate) []utils.ContinuousProfileCandidate {
+	candidates := make([]utils.ContinuousProfileCandidate, 0, len(pb))
+	for _, c := range pb {
+		candidates = append(candidates, utils.ContinuousProfileCandidate{
+			ProjectID:     c.GetProjectId(),
+			ProfilerID:    c.GetProfilerId(),
+			ChunkID:       c.GetChunkId(),
+			TransactionID: c.GetTransactionId(),
+			ThreadID:      c.ThreadId,
+			Start:         c.GetStart(),
+			End:           c.GetEnd(),
+		})
+	}
+	return candidates
+}
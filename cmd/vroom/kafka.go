@@ -9,9 +9,12 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/getsentry/sentry-go"
+	"github.com/getsentry/vroom/internal/contention"
 	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/occurrence"
 	"github.com/getsentry/vroom/internal/platform"
 	"github.com/getsentry/vroom/internal/profile"
+	"github.com/getsentry/vroom/internal/telemetry"
 	"github.com/segmentio/kafka-go"
 )
 
@@ -30,6 +33,7 @@ type (
 		RetentionDays   int                         `json:"retention_days"`
 		Timestamp       int64                       `json:"timestamp"`
 		TransactionName string                      `json:"transaction_name"`
+		Waits           *contention.Summary         `json:"waits,omitempty"`
 	}
 
 	// ProfileKafkaMessage is representing the struct we send to Kafka to insert a profile in ClickHouse.
@@ -79,8 +83,12 @@ type (
 	}
 )
 
-func buildFunctionsKafkaMessage(p profile.Profile, functions []nodetree.CallTreeFunction) FunctionsKafkaMessage {
-	return FunctionsKafkaMessage{
+func buildFunctionsKafkaMessage(
+	p profile.Profile,
+	functions []nodetree.CallTreeFunction,
+	waits contention.Summary,
+) FunctionsKafkaMessage {
+	m := FunctionsKafkaMessage{
 		Environment:     p.Environment(),
 		Functions:       functions,
 		ID:              p.ID(),
@@ -92,6 +100,10 @@ func buildFunctionsKafkaMessage(p profile.Profile, functions []nodetree.CallTree
 		Timestamp:       p.Timestamp().Unix(),
 		TransactionName: p.Transaction().Name,
 	}
+	if waits.TotalWaitNS > 0 {
+		m.Waits = &waits
+	}
+	return m
 }
 
 func buildProfileKafkaMessage(p profile.Profile) ProfileKafkaMessage {
@@ -174,3 +186,27 @@ type KafkaWriter interface {
 	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
 	Close() error
 }
+
+// countKafkaWriteErrors returns a completion callback counting messages
+// which failed to be written asynchronously. topic is used for messages
+// without one, when the writer has a topic set.
+func countKafkaWriteErrors(topic string) func(messages []kafka.Message, err error) {
+	return func(messages []kafka.Message, err error) {
+		if err == nil {
+			return
+		}
+		for _, m := range messages {
+			t := m.Topic
+			if t == "" {
+				t = topic
+			}
+			telemetry.KafkaWriteErrors.WithLabelValues(t).Inc()
+		}
+	}
+}
+
+func countOccurrences(occurrences []*occurrence.Occurrence) {
+	for _, o := range occurrences {
+		telemetry.OccurrencesEmitted.WithLabelValues(string(o.Category())).Inc()
+	}
+}
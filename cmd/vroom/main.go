@@ -24,10 +24,18 @@ import (
 	_ "gocloud.dev/blob/gcsblob"
 	_ "gocloud.dev/blob/s3blob"
 	"gocloud.dev/gcerrors"
+	"google.golang.org/grpc"
 
 	"github.com/getsentry/vroom/internal/httputil"
 	"github.com/getsentry/vroom/internal/logutil"
+	"github.com/getsentry/vroom/internal/occurrence"
+	"github.com/getsentry/vroom/internal/openapi"
+	"github.com/getsentry/vroom/internal/ratelimit"
+	"github.com/getsentry/vroom/internal/regression"
+	"github.com/getsentry/vroom/internal/sample"
 	"github.com/getsentry/vroom/internal/storageutil"
+	"github.com/getsentry/vroom/internal/telemetry"
+	"github.com/getsentry/vroom/internal/utils"
 )
 
 type environment struct {
@@ -40,11 +48,23 @@ type environment struct {
 	storage *blob.Bucket
 
 	metricsClient *http.Client
+
+	detectionOverrides map[uint64]utils.DetectionOptions
+
+	regressions *regression.Detector
+
+	authenticator *httputil.Authenticator
+
+	ingestLimiter *ratelimit.Limiter
+	readLimiter   *ratelimit.Limiter
+	profileLimits sample.Limits
+
+	deduplicator *occurrence.Deduplicator
 }
 
 var (
 	release  string
-	readJobs chan storageutil.ReadJob
+	readJobs *storageutil.Scheduler
 )
 
 const (
@@ -59,6 +79,55 @@ func newEnvironment() (*environment, error) {
 		return nil, err
 	}
 
+	if e.config.DetectionOverridesPath != "" {
+		e.detectionOverrides, err = readDetectionOverrides(e.config.DetectionOverridesPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if e.config.AuthKeys != "" {
+		keys, err := httputil.ParseAuthKeys(e.config.AuthKeys)
+		if err != nil {
+			return nil, err
+		}
+		e.authenticator = httputil.NewAuthenticator(keys, e.config.AuthMaxClockSkew)
+	}
+
+	e.profileLimits = sample.Limits{
+		MaxSamples: e.config.MaxProfileSamples,
+		MaxFrames:  e.config.MaxProfileFrames,
+		MaxThreads: e.config.MaxProfileThreads,
+	}
+
+	var rateLimitOverrides ratelimit.Overrides
+	if e.config.RateLimitOverridesPath != "" {
+		rateLimitOverrides, err = ratelimit.ReadOverrides(e.config.RateLimitOverridesPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	e.ingestLimiter = ratelimit.NewLimiter(
+		ratelimit.Limit{Rate: e.config.IngestRateLimit, Burst: e.config.IngestRateLimitBurst},
+		rateLimitOverrides.Ingest,
+	)
+	e.readLimiter = ratelimit.NewLimiter(
+		ratelimit.Limit{Rate: e.config.ReadRateLimit, Burst: e.config.ReadRateLimitBurst},
+		rateLimitOverrides.Read,
+	)
+
+	if e.config.RegressionDetectionInterval > 0 {
+		e.regressions = regression.NewDetector(regression.DefaultOptions)
+	}
+
+	if e.config.OccurrencesDedupLimit > 0 && e.config.OccurrencesDedupWindow > 0 {
+		e.deduplicator = occurrence.NewDeduplicator(
+			occurrence.NewMemoryDedupBackend(),
+			e.config.OccurrencesDedupWindow,
+			e.config.OccurrencesDedupLimit,
+		)
+	}
+
 	ctx := context.Background()
 	e.storage, err = blob.OpenBucket(ctx, e.config.BucketURL)
 	if err != nil {
@@ -82,6 +151,7 @@ func newEnvironment() (*environment, error) {
 		Topic:        e.config.OccurrencesKafkaTopic,
 		WriteTimeout: 3 * time.Second,
 		Transport:    transport,
+		Completion:   countKafkaWriteErrors(e.config.OccurrencesKafkaTopic),
 	}
 
 	e.profilingWriter = &kafka.Writer{
@@ -94,6 +164,7 @@ func newEnvironment() (*environment, error) {
 		ReadTimeout:  3 * time.Second,
 		WriteTimeout: 3 * time.Second,
 		Transport:    transport,
+		Completion:   countKafkaWriteErrors(""),
 	}
 	e.metricSummaryWriter = &kafka.Writer{
 		Addr:         kafka.TCP(e.config.SpansKafkaBrokers...),
@@ -104,6 +175,7 @@ func newEnvironment() (*environment, error) {
 		Topic:        e.config.MetricsSummaryKafkaTopic,
 		WriteTimeout: 3 * time.Second,
 		Transport:    transport,
+		Completion:   countKafkaWriteErrors(e.config.MetricsSummaryKafkaTopic),
 	}
 	e.metricsClient = &http.Client{
 		Timeout: time.Second * 5,
@@ -158,6 +230,16 @@ func (e *environment) newRouter() (*httprouter.Router, error) {
 			"/organizations/:organization_id/projects/:project_id/raw_profiles/:profile_id",
 			e.getRawProfile,
 		},
+		{
+			http.MethodGet,
+			"/organizations/:organization_id/projects/:project_id/profiles/:profile_id/waits",
+			e.getProfileWaits,
+		},
+		{
+			http.MethodGet,
+			"/organizations/:organization_id/projects/:project_id/profiles/:profile_id/functions",
+			e.getProfileFunctions,
+		},
 		{
 			http.MethodPost,
 			"/organizations/:organization_id/projects/:project_id/flamegraph",
@@ -183,20 +265,72 @@ func (e *environment) newRouter() (*httprouter.Router, error) {
 			"/organizations/:organization_id/metrics",
 			e.postMetrics,
 		},
+		{
+			http.MethodPost,
+			"/organizations/:organization_id/functions/:fingerprint/callgraph",
+			e.postCallgraph,
+		},
 		{http.MethodGet, "/health", e.getHealth},
+		{http.MethodGet, "/openapi.json", e.getOpenAPI},
 		{http.MethodPost, "/chunk", e.postChunk},
 		{http.MethodPost, "/profile", e.postProfile},
 		{http.MethodPost, "/regressed", e.postRegressed},
 	}
 
+	// Chunks are read to serve a profile to a user waiting for it while
+	// aggregations read many profiles and can wait. These routes reading many
+	// objects are also rate limited per organization.
+	readPriorities := map[string]storageutil.Priority{
+		"/organizations/:organization_id/projects/:project_id/chunks":            storageutil.PriorityHigh,
+		"/organizations/:organization_id/projects/:project_id/flamegraph":        storageutil.PriorityLow,
+		"/organizations/:organization_id/projects/:project_id/chunks-flamegraph": storageutil.PriorityLow,
+		"/organizations/:organization_id/flamegraph":                             storageutil.PriorityLow,
+		"/organizations/:organization_id/metrics":                                storageutil.PriorityLow,
+		"/organizations/:organization_id/functions/:fingerprint/callgraph":       storageutil.PriorityLow,
+	}
+
+	// The health check and the API description are public.
+	publicRoutes := map[string]struct{}{
+		"/health":       {},
+		"/openapi.json": {},
+	}
+
+	spec, err := openapi.Parse(openapi.Raw)
+	if err != nil {
+		return nil, err
+	}
+
 	router := httprouter.New()
 
 	for _, route := range routes {
-		handlerFunc := httputil.AnonymizeTransactionName(route.handler)
-		handlerFunc = httputil.DecompressPayload(handlerFunc)
+		operation, err := spec.Operation(route.method, route.path)
+		if err != nil {
+			return nil, err
+		}
+
+		readOptions := storageutil.ReadOptions{
+			Priority:       storageutil.PriorityNormal,
+			MaxConcurrency: e.config.ReadMaxConcurrencyPerRequest,
+		}
+		if priority, ok := readPriorities[route.path]; ok {
+			readOptions.Priority = priority
+		}
+		handlerFunc := httputil.WithReadOptions(readOptions, route.handler)
+		handlerFunc = httputil.AnonymizeTransactionName(handlerFunc)
+		handlerFunc = httputil.ValidateRequest(operation, handlerFunc)
+		handlerFunc = httputil.LimitBody(e.config.MaxDecompressedBodySize, handlerFunc)
+		handlerFunc = httputil.DecompressPayload(e.config.MaxDecompressedBodySize, handlerFunc)
+		// Rate limited requests are rejected before any work on their body.
+		if _, ok := readPriorities[route.path]; ok {
+			handlerFunc = httputil.RateLimit(e.readLimiter, handlerFunc)
+		}
+		if _, public := publicRoutes[route.path]; e.authenticator != nil && !public {
+			handlerFunc = e.authenticator.Authenticate(route.path, handlerFunc)
+		}
+		handlerFunc = httputil.LimitBody(e.config.MaxBodySize, handlerFunc)
 		handler := compress(handlerFunc)
 
-		router.Handler(route.method, route.path, handler)
+		router.Handler(route.method, route.path, telemetry.InstrumentRoute(route.path, handler))
 	}
 
 	return router, nil
@@ -247,6 +381,22 @@ func main() {
 		Handler:           sentryhttp.New(sentryhttp.Options{}).Handle(router),
 	}
 
+	var grpcServer *grpc.Server
+	if env.config.GRPCPort > 0 {
+		grpcServer = env.newGRPCServer()
+	}
+
+	var metricsServer *http.Server
+	if env.config.MetricsPort > 0 {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", telemetry.Handler())
+		metricsServer = &http.Server{
+			Addr:              fmt.Sprintf(":%d", env.config.MetricsPort),
+			ReadHeaderTimeout: time.Second,
+			Handler:           mux,
+		}
+	}
+
 	waitForShutdown := make(chan os.Signal)
 	go func() {
 		c := make(chan os.Signal, 1)
@@ -261,14 +411,75 @@ func main() {
 			slog.Error("error shutting down server", "err", err)
 		}
 
+		if grpcServer != nil {
+			stopped := make(chan struct{})
+			go func() {
+				grpcServer.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+			case <-cctx.Done():
+				grpcServer.Stop()
+			}
+		}
+
+		if metricsServer != nil {
+			if err := metricsServer.Shutdown(cctx); err != nil {
+				sentry.CaptureException(err)
+				slog.Error("error shutting down metrics server", "err", err)
+			}
+		}
+
 		close(waitForShutdown)
 	}()
 
 	slog.Info("vroom started")
 
-	readJobs = make(chan storageutil.ReadJob, env.config.WorkerPoolSize)
-	for i := 0; i < env.config.WorkerPoolSize; i++ {
-		go storageutil.ReadWorker(readJobs)
+	readJobs = storageutil.NewScheduler(env.config.WorkerPoolSize, env.config.ReadQueueSize)
+	telemetry.ReadWorkers.Set(float64(env.config.WorkerPoolSize))
+	telemetry.RegisterGaugeFunc(
+		"read_jobs_queue_depth",
+		"Number of storage read jobs waiting for a worker.",
+		func() float64 {
+			return float64(readJobs.Len())
+		},
+	)
+
+	if metricsServer != nil {
+		go func() {
+			err := metricsServer.ListenAndServe()
+			if err != nil && err != http.ErrServerClosed {
+				sentry.CaptureException(err)
+				slog.Error("metrics server failed", "err", err)
+			}
+		}()
+	}
+
+	if grpcServer != nil {
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", env.config.GRPCPort))
+		if err != nil {
+			sentry.CaptureException(err)
+			log.Fatal("error listening for gRPC", err)
+		}
+		go func() {
+			err := grpcServer.Serve(listener)
+			if err != nil {
+				sentry.CaptureException(err)
+				slog.Error("gRPC server failed", "err", err)
+			}
+		}()
+	}
+
+	if env.regressions != nil {
+		telemetry.RegisterGaugeFunc(
+			"regression_series",
+			"Number of function series kept in memory to detect regressions.",
+			func() float64 {
+				return float64(env.regressions.Len())
+			},
+		)
+		go env.detectRegressions(env.config.RegressionDetectionInterval, waitForShutdown)
 	}
 
 	err = server.ListenAndServe()
@@ -279,12 +490,18 @@ func main() {
 
 	<-waitForShutdown
 
-	// Shutdown the rest of the environment after the HTTP connections are closed
-	close(readJobs)
+	// Shutdown the rest of the environment after the HTTP and gRPC connections are closed
+	readJobs.Close()
 	env.shutdown()
 	slog.Info("vroom graceful shutdown")
 }
 
+func (e *environment) getOpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(openapi.Raw)
+}
+
 func (e *environment) getHealth(w http.ResponseWriter, _ *http.Request) {
 	if _, err := os.Stat("/tmp/vroom.down"); err != nil {
 		w.WriteHeader(http.StatusOK)
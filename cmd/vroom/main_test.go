@@ -0,0 +1,95 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getsentry/vroom/internal/ratelimit"
+)
+
+func TestRouterValidatesRequests(t *testing.T) {
+	env := environment{
+		config: ServiceConfig{
+			MaxBodySize:             1 << 20,
+			MaxDecompressedBodySize: 1 << 20,
+		},
+	}
+	// newRouter fails when a route isn't documented.
+	router, err := env.newRouter()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		method      string
+		target      string
+		want        int
+		contentType string
+	}{
+		{
+			name:        "openapi document",
+			method:      http.MethodGet,
+			target:      "/openapi.json",
+			want:        http.StatusOK,
+			contentType: "application/json",
+		},
+		{
+			name:        "invalid parameter",
+			method:      http.MethodGet,
+			target:      "/organizations/1/projects/1/profiles/not-a-uuid",
+			want:        http.StatusBadRequest,
+			contentType: "application/json",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(test.method, test.target, nil))
+			if w.Code != test.want {
+				t.Fatalf("expected status %d, got %d", test.want, w.Code)
+			}
+			if contentType := w.Header().Get("Content-Type"); contentType != test.contentType {
+				t.Fatalf("expected content type %q, got %q", test.contentType, contentType)
+			}
+		})
+	}
+}
+
+func TestRouterRateLimitsReads(t *testing.T) {
+	env := environment{
+		config: ServiceConfig{
+			MaxBodySize:             1 << 20,
+			MaxDecompressedBodySize: 1 << 20,
+		},
+		readLimiter: ratelimit.NewLimiter(ratelimit.Limit{}, map[uint64]ratelimit.Limit{1: {Rate: 0.5, Burst: 1}}),
+	}
+	router, err := env.newRouter()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The body is invalid, requests within the limit are rejected by the
+	// validation while the ones over it are rejected before.
+	post := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/organizations/1/flamegraph", strings.NewReader(`{"transaction":"invalid"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	if w := post(); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	w := post()
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status %d, got %d", http.StatusTooManyRequests, w.Code)
+	}
+	if retryAfter := w.Header().Get("Retry-After"); retryAfter != "2" {
+		t.Fatalf("expected to retry after 2 seconds, got %q", retryAfter)
+	}
+}
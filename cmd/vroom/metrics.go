@@ -1,14 +1,19 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/getsentry/sentry-go"
 	"github.com/julienschmidt/httprouter"
 
 	"github.com/getsentry/vroom/internal/metrics"
+	"github.com/getsentry/vroom/internal/storageutil"
 	"github.com/getsentry/vroom/internal/utils"
 )
 
@@ -16,13 +21,29 @@ type (
 	postMetricsRequestBody struct {
 		Transaction []utils.TransactionProfileCandidate `json:"transaction"`
 		Continuous  []utils.ContinuousProfileCandidate  `json:"continuous"`
+		// Quantiles are returned in addition to p75, p95 and p99.
+		Quantiles []float64 `json:"quantiles"`
+		// IncludeSketches returns the sketches used to compute the quantiles
+		// so results from several calls can be merged.
+		IncludeSketches bool `json:"include_sketches"`
+		// GroupBy returns metrics for each combination of values of these
+		// dimensions in addition to the global ones.
+		GroupBy []metrics.Dimension `json:"group_by"`
+		// RankBy orders functions by self_time (default) or total_time.
+		RankBy string `json:"rank_by"`
+		// Interval is the size in seconds of the buckets of the time series
+		// returned for each function, at least metrics.MinSeriesInterval.
+		Interval uint64 `json:"interval"`
 	}
 
 	postMetricsResponse struct {
-		FunctionsMetrics []utils.FunctionMetrics `json:"functions_metrics"`
+		FunctionsMetrics []utils.FunctionMetrics      `json:"functions_metrics"`
+		Groups           []utils.FunctionMetricsGroup `json:"groups,omitempty"`
 	}
 )
 
+var errInvalidMetricsRequest = errors.New("metrics: invalid request")
+
 func (env *environment) postMetrics(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	hub := sentry.GetHubFromContext(ctx)
@@ -53,17 +74,21 @@ func (env *environment) postMetrics(w http.ResponseWriter, r *http.Request) {
 	}
 
 	s = sentry.StartSpan(ctx, "processing")
-	ma := metrics.NewAggregator(maxUniqueFunctionsPerProfile, 5)
-	functionsMetrics, err := ma.GetMetricsFromCandidates(
-		ctx,
-		env.storage,
-		organizationID,
-		body.Transaction,
-		body.Continuous,
-		readJobs,
-	)
+	response, err := env.functionsMetrics(ctx, organizationID, body)
 	s.Finish()
 	if err != nil {
+		if errors.Is(err, errInvalidMetricsRequest) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, storageutil.ErrTooManyReadJobs) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, storageutil.ErrSchedulerSaturated) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
 		if hub != nil {
 			hub.CaptureException(err)
 		}
@@ -73,9 +98,7 @@ func (env *environment) postMetrics(w http.ResponseWriter, r *http.Request) {
 
 	s = sentry.StartSpan(ctx, "json.marshal")
 	defer s.Finish()
-	b, err := json.Marshal(postMetricsResponse{
-		FunctionsMetrics: functionsMetrics,
-	})
+	b, err := json.Marshal(response)
 	if err != nil {
 		if hub != nil {
 			hub.CaptureException(err)
@@ -88,3 +111,62 @@ func (env *environment) postMetrics(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	_, _ = w.Write(b)
 }
+
+// functionsMetrics aggregates the metrics of the functions of the candidates.
+func (env *environment) functionsMetrics(
+	ctx context.Context,
+	organizationID uint64,
+	body postMetricsRequestBody,
+) (postMetricsResponse, error) {
+	for _, q := range body.Quantiles {
+		if q <= 0 || q > 1 {
+			return postMetricsResponse{}, errInvalidMetricsRequest
+		}
+	}
+
+	for _, d := range body.GroupBy {
+		if !metrics.IsValidDimension(d) {
+			return postMetricsResponse{}, errInvalidMetricsRequest
+		}
+	}
+
+	switch body.RankBy {
+	case "", metrics.RankBySelfTime, metrics.RankByTotalTime:
+	default:
+		return postMetricsResponse{}, errInvalidMetricsRequest
+	}
+
+	interval := time.Duration(body.Interval) * time.Second
+	if interval > 0 && interval < metrics.MinSeriesInterval {
+		return postMetricsResponse{}, errInvalidMetricsRequest
+	}
+
+	ma := metrics.NewAggregator(maxUniqueFunctionsPerProfile, 5)
+	ma.Quantiles = body.Quantiles
+	ma.IncludeSketches = body.IncludeSketches
+	ma.GroupBy = body.GroupBy
+	ma.RankBy = body.RankBy
+	ma.Interval = interval
+	functionsMetrics, err := ma.GetMetricsFromCandidates(
+		ctx,
+		env.storage,
+		organizationID,
+		body.Transaction,
+		body.Continuous,
+		readJobs,
+	)
+	if err != nil {
+		if errors.Is(err, metrics.ErrTooManySeriesBuckets) {
+			return postMetricsResponse{}, fmt.Errorf("%w: %w", errInvalidMetricsRequest, err)
+		}
+		return postMetricsResponse{}, err
+	}
+
+	response := postMetricsResponse{
+		FunctionsMetrics: functionsMetrics,
+	}
+	if len(body.GroupBy) > 0 {
+		response.Groups = ma.ToGroupsMetrics(functionsMetrics)
+	}
+	return response, nil
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/getsentry/sentry-go"
 	"github.com/google/uuid"
@@ -15,10 +16,13 @@ import (
 	"gocloud.dev/gcerrors"
 	"google.golang.org/api/googleapi"
 
+	"github.com/getsentry/vroom/internal/contention"
+	"github.com/getsentry/vroom/internal/httputil"
 	"github.com/getsentry/vroom/internal/metrics"
 	"github.com/getsentry/vroom/internal/occurrence"
 	"github.com/getsentry/vroom/internal/profile"
 	"github.com/getsentry/vroom/internal/storageutil"
+	"github.com/getsentry/vroom/internal/telemetry"
 )
 
 const (
@@ -35,6 +39,10 @@ func (env *environment) postProfile(w http.ResponseWriter, r *http.Request) {
 	body, err := io.ReadAll(r.Body)
 	s.Finish()
 	if err != nil {
+		if httputil.IsBodyTooLarge(err) {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
 		hub.CaptureException(err)
 		w.WriteHeader(http.StatusBadRequest)
 		return
@@ -54,6 +62,21 @@ func (env *environment) postProfile(w http.ResponseWriter, r *http.Request) {
 
 	orgID := p.OrganizationID()
 
+	if !httputil.OrganizationAllowed(ctx, orgID) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	if ok, retryAfter := env.ingestLimiter.Allow(orgID, p.ProjectID()); !ok {
+		httputil.WriteRateLimited(w, telemetry.RateLimitIngest, retryAfter)
+		return
+	}
+
+	if err := p.Validate(env.profileLimits); err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
 	hub.Scope().SetContext("Profile metadata", map[string]interface{}{
 		"organization_id": strconv.FormatUint(orgID, 10),
 		"profile_id":      p.ID(),
@@ -62,6 +85,7 @@ func (env *environment) postProfile(w http.ResponseWriter, r *http.Request) {
 	})
 
 	profilePlatform := p.Platform()
+	telemetry.ProfilesIngested.WithLabelValues(string(profilePlatform)).Inc()
 
 	hub.Scope().SetTags(map[string]string{
 		"platform": string(profilePlatform),
@@ -119,7 +143,10 @@ func (env *environment) postProfile(w http.ResponseWriter, r *http.Request) {
 		if p.IsSampled() {
 			s = sentry.StartSpan(ctx, "processing")
 			s.Description = "Find occurrences"
-			occurrences := occurrence.Find(p, callTrees)
+			// Overrides sent with the profile take precedence over the ones
+			// configured for the project.
+			options := env.detectionOverrides[p.ProjectID()].Merge(p.GetOptions().Detection)
+			occurrences := occurrence.Find(p, callTrees, options)
 			s.Finish()
 
 			// Filter in-place occurrences without a type.
@@ -131,6 +158,16 @@ func (env *environment) postProfile(w http.ResponseWriter, r *http.Request) {
 				}
 			}
 			occurrences = occurrences[:i]
+			if env.deduplicator != nil {
+				s = sentry.StartSpan(ctx, "processing")
+				s.Description = "Deduplicate occurrences"
+				occurrences, err = env.deduplicator.Filter(occurrences, time.Now())
+				s.Finish()
+				if err != nil {
+					// Report the error but send all occurrences
+					hub.CaptureException(err)
+				}
+			}
 			s = sentry.StartSpan(ctx, "processing")
 			s.Description = "Build Kafka message batch"
 			occurrenceMessages, err := occurrence.GenerateKafkaMessageBatch(occurrences)
@@ -146,6 +183,8 @@ func (env *environment) postProfile(w http.ResponseWriter, r *http.Request) {
 				if err != nil {
 					// Report the error but don't fail profile insertion
 					hub.CaptureException(err)
+				} else {
+					countOccurrences(occurrences)
 				}
 			}
 		}
@@ -159,9 +198,23 @@ func (env *environment) postProfile(w http.ResponseWriter, r *http.Request) {
 		functionsDataset := metrics.CapAndFilterFunctions(functions, maxUniqueFunctionsPerProfile, false)
 		s.Finish()
 
+		if env.regressions != nil {
+			// Only stored profiles can be used as an example for an occurrence.
+			var profileID string
+			if p.IsSampled() {
+				profileID = p.ID()
+			}
+			env.regressions.AddFunctions(p.OrganizationID(), p.ProjectID(), profileID, p.Timestamp(), functionsDataset)
+		}
+
+		s = sentry.StartSpan(ctx, "processing")
+		s.Description = "Summarize thread waits"
+		waits := contention.Summarize(p.Platform(), callTrees, p.Transaction().ActiveThreadID)
+		s.Finish()
+
 		s = sentry.StartSpan(ctx, "json.marshal")
 		s.Description = "Marshal functions Kafka message"
-		b, err := json.Marshal(buildFunctionsKafkaMessage(p, functionsDataset))
+		b, err := json.Marshal(buildFunctionsKafkaMessage(p, functionsDataset, waits))
 		s.Finish()
 		if err != nil {
 			hub.CaptureException(err)
@@ -247,84 +300,68 @@ func (env *environment) postProfile(w http.ResponseWriter, r *http.Request) {
 func (env *environment) getRawProfile(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	hub := sentry.GetHubFromContext(ctx)
-	ps := httprouter.ParamsFromContext(ctx)
-	rawOrganizationID := ps.ByName("organization_id")
-	organizationID, err := strconv.ParseUint(rawOrganizationID, 10, 64)
-	if err != nil {
-		hub.CaptureException(err)
-		w.WriteHeader(http.StatusBadRequest)
+	p, ok := env.readProfile(w, r)
+	if !ok {
 		return
 	}
 
-	hub.Scope().SetTag("organization_id", rawOrganizationID)
-
-	rawProjectID := ps.ByName("project_id")
-	projectID, err := strconv.ParseUint(rawProjectID, 10, 64)
+	s := sentry.StartSpan(ctx, "json.marshal")
+	defer s.Finish()
+	b, err := json.Marshal(p)
 	if err != nil {
-		sentry.CaptureException(err)
-		w.WriteHeader(http.StatusBadRequest)
+		hub.CaptureException(err)
+		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	hub.Scope().SetTag("project_id", rawProjectID)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=3600, immutable")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(b)
+}
 
-	profileID := ps.ByName("profile_id")
-	_, err = uuid.Parse(profileID)
-	if err != nil {
-		hub.CaptureException(err)
-		w.WriteHeader(http.StatusBadRequest)
+func (env *environment) getProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	qs := r.URL.Query()
+	hub := sentry.GetHubFromContext(ctx)
+	p, ok := env.readProfile(w, r)
+	if !ok {
 		return
 	}
 
-	hub.Scope().SetTag("profile_id", profileID)
-	s := sentry.StartSpan(ctx, "profile.read")
-	s.Description = "Read profile from GCS or Snuba"
+	s := sentry.StartSpan(ctx, "json.marshal")
+	defer s.Finish()
 
-	var p profile.Profile
-	err = storageutil.UnmarshalCompressed(
-		ctx,
-		env.storage,
-		profile.StoragePath(organizationID, projectID, profileID),
-		&p,
-	)
-	s.Finish()
-	if err != nil {
-		if errors.Is(err, storageutil.ErrObjectNotFound) {
-			w.WriteHeader(http.StatusNotFound)
+	if format := qs.Get("format"); format == "sample" && p.IsSampleFormat() {
+		hub.Scope().SetTag("format", "sample")
+		b, err := json.Marshal(p)
+		if err != nil {
+			hub.CaptureException(err)
+			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
-		var e *googleapi.Error
-		if ok := errors.As(err, &e); ok {
-			hub.Scope().SetContext("Google Cloud Storage Error", map[string]interface{}{
-				"body":    e.Body,
-				"code":    e.Code,
-				"details": e.Details,
-				"message": e.Message,
-			})
-		}
-		hub.CaptureException(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=3600, immutable")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(b)
 		return
 	}
 
-	s = sentry.StartSpan(ctx, "json.marshal")
-	defer s.Finish()
-	b, err := json.Marshal(p)
+	hub.Scope().SetTag("format", "speedscope")
+	o, err := p.Speedscope()
 	if err != nil {
 		hub.CaptureException(err)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-
-	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("Cache-Control", "public, max-age=3600, immutable")
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(b)
+	writeJSONStream(w, r, o.WriteJSON)
 }
 
-func (env *environment) getProfile(w http.ResponseWriter, r *http.Request) {
+// readProfile reads the profile of the route parameters. It writes the error
+// response and returns false if it can't.
+func (env *environment) readProfile(w http.ResponseWriter, r *http.Request) (profile.Profile, bool) {
 	ctx := r.Context()
-	qs := r.URL.Query()
 	hub := sentry.GetHubFromContext(ctx)
 	ps := httprouter.ParamsFromContext(ctx)
 	rawOrganizationID := ps.ByName("organization_id")
@@ -332,7 +369,7 @@ func (env *environment) getProfile(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		hub.CaptureException(err)
 		w.WriteHeader(http.StatusBadRequest)
-		return
+		return profile.Profile{}, false
 	}
 
 	hub.Scope().SetTag("organization_id", rawOrganizationID)
@@ -340,9 +377,9 @@ func (env *environment) getProfile(w http.ResponseWriter, r *http.Request) {
 	rawProjectID := ps.ByName("project_id")
 	projectID, err := strconv.ParseUint(rawProjectID, 10, 64)
 	if err != nil {
-		sentry.CaptureException(err)
+		hub.CaptureException(err)
 		w.WriteHeader(http.StatusBadRequest)
-		return
+		return profile.Profile{}, false
 	}
 
 	hub.Scope().SetTag("project_id", rawProjectID)
@@ -352,12 +389,12 @@ func (env *environment) getProfile(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		hub.CaptureException(err)
 		w.WriteHeader(http.StatusBadRequest)
-		return
+		return profile.Profile{}, false
 	}
 
 	hub.Scope().SetTag("profile_id", profileID)
 	s := sentry.StartSpan(ctx, "profile.read")
-	s.Description = "Read profile from GCS or Snuba"
+	s.Description = "Read profile from GCS"
 
 	var p profile.Profile
 	err = storageutil.UnmarshalCompressed(
@@ -370,7 +407,7 @@ func (env *environment) getProfile(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		if errors.Is(err, storageutil.ErrObjectNotFound) {
 			w.WriteHeader(http.StatusNotFound)
-			return
+			return profile.Profile{}, false
 		}
 		var e *googleapi.Error
 		if ok := errors.As(err, &e); ok {
@@ -383,39 +420,9 @@ func (env *environment) getProfile(w http.ResponseWriter, r *http.Request) {
 		}
 		hub.CaptureException(err)
 		w.WriteHeader(http.StatusInternalServerError)
-		return
+		return profile.Profile{}, false
 	}
 
 	hub.Scope().SetTag("platform", string(p.Platform()))
-
-	s = sentry.StartSpan(ctx, "json.marshal")
-	defer s.Finish()
-
-	var i interface{}
-
-	if format := qs.Get("format"); format == "sample" && p.IsSampleFormat() {
-		hub.Scope().SetTag("format", "sample")
-		i = p
-	} else {
-		hub.Scope().SetTag("format", "speedscope")
-		o, err := p.Speedscope()
-		if err != nil {
-			hub.CaptureException(err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-		i = o
-	}
-
-	b, err := json.Marshal(i)
-	if err != nil {
-		hub.CaptureException(err)
-		w.WriteHeader(http.StatusInternalServerError)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Cache-Control", "public, max-age=3600, immutable")
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(b)
+	return p, true
 }
@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"os"
+	"time"
 
 	"github.com/getsentry/sentry-go"
+	"github.com/getsentry/vroom/internal/httputil"
 	"github.com/getsentry/vroom/internal/occurrence"
 )
 
@@ -20,6 +23,46 @@ func (env *environment) postRegressed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	for _, f := range regressedFunctions {
+		if !httputil.OrganizationAllowed(ctx, f.OrganizationID) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+	}
+
+	emitted, err := env.processRegressedFunctions(ctx, regressedFunctions)
+	if err != nil {
+		hub.CaptureException(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	s := sentry.StartSpan(ctx, "json.marshal")
+	data := struct {
+		Occurrences int                            `json:"occurrences"`
+		Emitted     []occurrence.RegressedFunction `json:"emitted"`
+	}{Occurrences: len(emitted), Emitted: emitted}
+	b, err := json.Marshal(data)
+	s.Finish()
+	if err != nil {
+		hub.CaptureException(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(b)
+}
+
+// processRegressedFunctions sends an occurrence for each regressed function
+// needing one and returns those functions. Functions failing to generate an
+// occurrence are reported and skipped.
+func (env *environment) processRegressedFunctions(
+	ctx context.Context,
+	regressedFunctions []occurrence.RegressedFunction,
+) ([]occurrence.RegressedFunction, error) {
+	hub := sentry.GetHubFromContext(ctx)
 	emitted := []occurrence.RegressedFunction{}
 	occurrences := []*occurrence.Occurrence{}
 	for _, regressedFunction := range regressedFunctions {
@@ -28,7 +71,9 @@ func (env *environment) postRegressed(w http.ResponseWriter, r *http.Request) {
 		occurrence, err := occurrence.ProcessRegressedFunction(ctx, env.storage, regressedFunction)
 		s.Finish()
 		if err != nil {
-			hub.CaptureException(err)
+			if hub != nil {
+				hub.CaptureException(err)
+			}
 			continue
 		} else if occurrence == nil {
 			continue
@@ -37,23 +82,69 @@ func (env *environment) postRegressed(w http.ResponseWriter, r *http.Request) {
 		occurrences = append(occurrences, occurrence)
 	}
 
-	s := sentry.StartSpan(ctx, "json.marshal")
-	data := struct {
-		Occurrences int                            `json:"occurrences"`
-		Emitted     []occurrence.RegressedFunction `json:"emitted"`
-	}{Occurrences: len(occurrences), Emitted: emitted}
-	b, err := json.Marshal(data)
+	occurrenceMessages, err := occurrence.GenerateKafkaMessageBatch(occurrences)
+	if err != nil {
+		return nil, err
+	}
+
+	s := sentry.StartSpan(ctx, "processing")
+	s.Description = "Send occurrences to Kafka"
+	err = env.occurrencesWriter.WriteMessages(ctx, occurrenceMessages...)
 	s.Finish()
 	if err != nil {
-		hub.CaptureException(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		return nil, err
+	}
+	countOccurrences(occurrences)
+	return emitted, nil
+}
+
+// detectRegressions periodically looks for regressions in the function
+// durations aggregated by vroom and sends occurrences for them.
+func (env *environment) detectRegressions(interval time.Duration, done <-chan os.Signal) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case now := <-ticker.C:
+			env.sendRegressions(now)
+		}
+	}
+}
+
+func (env *environment) sendRegressions(now time.Time) {
+	hub := sentry.CurrentHub().Clone()
+	ctx := sentry.SetHubOnContext(context.Background(), hub)
+	transaction := sentry.StartTransaction(ctx, "regression.detect")
+	defer transaction.Finish()
+	ctx = transaction.Context()
+
+	s := sentry.StartSpan(ctx, "processing")
+	s.Description = "Detect regressions"
+	regressedFunctions := env.regressions.Detect(now)
+	s.Finish()
+	if len(regressedFunctions) == 0 {
+		return
+	}
+
+	s = sentry.StartSpan(ctx, "processing")
+	s.Description = "Generate occurrences"
+	occurrences := occurrence.ProcessRegressedFunctions(
+		ctx,
+		hub,
+		env.storage,
+		regressedFunctions,
+		env.config.WorkerPoolSize,
+	)
+	s.Finish()
+	if len(occurrences) == 0 {
 		return
 	}
 
 	occurrenceMessages, err := occurrence.GenerateKafkaMessageBatch(occurrences)
 	if err != nil {
 		hub.CaptureException(err)
-		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
@@ -63,13 +154,9 @@ func (env *environment) postRegressed(w http.ResponseWriter, r *http.Request) {
 	s.Finish()
 	if err != nil {
 		hub.CaptureException(err)
-		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(b)
+	countOccurrences(occurrences)
 }
 
 func decodeRegressedFunctionPayload(ctx context.Context, r *http.Request) ([]occurrence.RegressedFunction, error) {
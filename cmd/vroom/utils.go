@@ -2,8 +2,10 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"math"
 	"net/http"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -11,10 +13,29 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/getsentry/sentry-go"
+	"github.com/getsentry/vroom/internal/jsonutil"
 	"github.com/getsentry/vroom/internal/nodetree"
 	"github.com/getsentry/vroom/internal/profile"
+	"github.com/getsentry/vroom/internal/speedscope"
+	"github.com/getsentry/vroom/internal/utils"
+	"github.com/getsentry/vroom/pkg/flamegraphbin"
 )
 
+// readDetectionOverrides reads a JSON file mapping project IDs to issue
+// detection overrides.
+func readDetectionOverrides(path string) (map[uint64]utils.DetectionOptions, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var overrides map[uint64]utils.DetectionOptions
+	err = json.Unmarshal(b, &overrides)
+	if err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
 func getFlamegraphNumWorkers(numProfiles, minNumWorkers int) int {
 	if numProfiles < minNumWorkers {
 		return numProfiles
@@ -87,3 +108,59 @@ type MetricSummary struct {
 	Sum   float64
 	Count uint64
 }
+
+// writeJSONStream writes a large JSON response without building it in
+// memory. The status is sent before the body so errors can only be reported,
+// writing stops when the client goes away.
+func writeJSONStream(w http.ResponseWriter, r *http.Request, write func(sw *jsonutil.StreamWriter)) {
+	ctx := r.Context()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	sw := jsonutil.NewStreamWriter(ctx, w)
+	write(sw)
+	err := sw.Flush()
+	if err != nil && ctx.Err() == nil {
+		if hub := sentry.GetHubFromContext(ctx); hub != nil {
+			hub.CaptureException(err)
+		}
+	}
+}
+
+// acceptsFlamegraphBinary returns whether the client asked for the binary
+// flamegraph format.
+func acceptsFlamegraphBinary(r *http.Request) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		for _, mediaRange := range strings.Split(accept, ",") {
+			mediaType, _, _ := strings.Cut(mediaRange, ";")
+			if strings.TrimSpace(mediaType) == flamegraphbin.ContentType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// writeFlamegraph writes an aggregated flamegraph in the format the client
+// asked for, JSON by default.
+func writeFlamegraph(w http.ResponseWriter, r *http.Request, output speedscope.Output) {
+	w.Header().Add("Vary", "Accept")
+	if !acceptsFlamegraphBinary(r) {
+		writeJSONStream(w, r, output.WriteJSON)
+		return
+	}
+	hub := sentry.GetHubFromContext(r.Context())
+	f, err := output.Flamegraph()
+	if err != nil {
+		if hub != nil {
+			hub.CaptureException(err)
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", flamegraphbin.ContentType)
+	w.WriteHeader(http.StatusOK)
+	err = flamegraphbin.Encode(w, f)
+	if err != nil && r.Context().Err() == nil && hub != nil {
+		hub.CaptureException(err)
+	}
+}
@@ -1,9 +1,14 @@
 package main
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/getsentry/vroom/internal/speedscope"
 	"github.com/getsentry/vroom/internal/testutil"
+	"github.com/getsentry/vroom/pkg/flamegraphbin"
 )
 
 func TestGetFlamegraphNumWorkers(t *testing.T) {
@@ -60,3 +65,74 @@ func TestGetFlamegraphNumWorkers(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteFlamegraph(t *testing.T) {
+	output := speedscope.Output{
+		Metadata: speedscope.ProfileMetadata{
+			ProfileView: speedscope.ProfileView{ProjectID: 1},
+		},
+		Profiles: []interface{}{
+			speedscope.SampledProfile{
+				IsMainThread:      true,
+				Samples:           [][]int{{0}, {0, 1}},
+				Weights:           []uint64{2, 1},
+				SampleCounts:      []uint64{2, 1},
+				SampleDurationsNs: []uint64{20, 10},
+				Type:              speedscope.ProfileTypeSampled,
+				Unit:              speedscope.ValueUnitCount,
+			},
+		},
+		Shared: speedscope.SharedData{
+			Frames: []speedscope.Frame{{Name: "a"}, {Name: "b", IsApplication: true}},
+		},
+		Truncation: &speedscope.Truncation{},
+	}
+
+	tests := []struct {
+		name        string
+		accept      string
+		contentType string
+	}{
+		{
+			name:        "default to JSON",
+			contentType: "application/json",
+		},
+		{
+			name:        "binary",
+			accept:      "application/json;q=0.5, application/x-vroom-flamegraph",
+			contentType: flamegraphbin.ContentType,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if test.accept != "" {
+				req.Header.Set("Accept", test.accept)
+			}
+			w := httptest.NewRecorder()
+			writeFlamegraph(w, req, output)
+
+			if diff := testutil.Diff(w.Header().Get("Content-Type"), test.contentType); diff != "" {
+				t.Fatalf("Result mismatch: got - want +\n%s", diff)
+			}
+			if test.contentType != flamegraphbin.ContentType {
+				var decoded speedscope.Output
+				if err := json.Unmarshal(w.Body.Bytes(), &decoded); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			decoded, err := flamegraphbin.Decode(w.Body)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := testutil.Diff(decoded.Profiles[0].Samples, [][]int{{0}, {0, 1}}); diff != "" {
+				t.Fatalf("Result mismatch: got - want +\n%s", diff)
+			}
+			if diff := testutil.Diff(decoded.Frames[1], flamegraphbin.Frame{Name: "b", IsApplication: true}); diff != "" {
+				t.Fatalf("Result mismatch: got - want +\n%s", diff)
+			}
+		})
+	}
+}
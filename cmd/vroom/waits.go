@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/getsentry/vroom/internal/contention"
+)
+
+func (env *environment) getProfileWaits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	hub := sentry.GetHubFromContext(ctx)
+	p, ok := env.readProfile(w, r)
+	if !ok {
+		return
+	}
+
+	s := sentry.StartSpan(ctx, "processing")
+	s.Description = "Generate call trees"
+	callTrees, err := p.CallTrees()
+	s.Finish()
+	if err != nil {
+		hub.CaptureException(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	s = sentry.StartSpan(ctx, "processing")
+	s.Description = "Summarize thread waits"
+	waits := contention.Summarize(p.Platform(), callTrees, p.Transaction().ActiveThreadID)
+	s.Finish()
+
+	s = sentry.StartSpan(ctx, "json.marshal")
+	defer s.Finish()
+	b, err := json.Marshal(waits)
+	if err != nil {
+		hub.CaptureException(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=3600, immutable")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(b)
+}
@@ -0,0 +1,37 @@
+// Package category lists the categories of the issues detected in profiles.
+// Project options refer to them to override the detection of a category.
+package category
+
+type Category string
+
+const (
+	AppStart           Category = "app_start"
+	Base64Decode       Category = "base64_decode"
+	Base64Encode       Category = "base64_encode"
+	Compression        Category = "compression"
+	CoreDataBlock      Category = "core_data_block"
+	CoreDataMerge      Category = "core_data_merge"
+	CoreDataRead       Category = "core_data_read"
+	CoreDataWrite      Category = "core_data_write"
+	Decompression      Category = "decompression"
+	FileRead           Category = "file_read"
+	FileWrite          Category = "file_write"
+	FrameDrop          Category = "frame_drop"
+	FunctionRegression Category = "function_regression"
+	HTTP               Category = "http"
+	ImageDecode        Category = "image_decode"
+	ImageEncode        Category = "image_encode"
+	JSONDecode         Category = "json_decode"
+	JSONEncode         Category = "json_encode"
+	MLModelInference   Category = "ml_model_inference"
+	MLModelLoad        Category = "ml_model_load"
+	Regex              Category = "regex"
+	SQL                Category = "sql"
+	SourceContext      Category = "source_context"
+	ThreadWait         Category = "thread_wait"
+	ViewInflation      Category = "view_inflation"
+	ViewLayout         Category = "view_layout"
+	ViewRender         Category = "view_render"
+	ViewUpdate         Category = "view_update"
+	XPC                Category = "xpc"
+)
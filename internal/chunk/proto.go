@@ -0,0 +1,86 @@
+package chunk
+
+import (
+	"encoding/json"
+
+	"github.com/getsentry/vroom/pkg/vroompb"
+)
+
+// Proto converts a chunk to its gRPC representation.
+func (c Chunk) Proto() (*vroompb.Chunk, error) {
+	debugMeta, err := json.Marshal(c.DebugMeta)
+	if err != nil {
+		return nil, err
+	}
+	pb := &vroompb.Chunk{
+		ChunkId:        c.ID,
+		ProfilerId:     c.ProfilerID,
+		Environment:    c.Environment,
+		Platform:       string(c.Platform),
+		Release:        c.Release,
+		Version:        c.Version,
+		OrganizationId: c.OrganizationID,
+		ProjectId:      c.ProjectID,
+		Received:       c.Received,
+		RetentionDays:  int32(c.RetentionDays),
+		Profile:        c.Profile.proto(),
+		DebugMeta:      debugMeta,
+		Measurements:   c.Measurements,
+	}
+	return pb, nil
+}
+
+func (d Data) proto() *vroompb.ChunkData {
+	pb := &vroompb.ChunkData{
+		Frames:  make([]*vroompb.ChunkFrame, 0, len(d.Frames)),
+		Samples: make([]*vroompb.ChunkSample, 0, len(d.Samples)),
+		Stacks:  make([]*vroompb.Indices, 0, len(d.Stacks)),
+	}
+	for _, f := range d.Frames {
+		pb.Frames = append(pb.Frames, &vroompb.ChunkFrame{
+			Function:        f.Function,
+			Module:          f.Module,
+			Package:         f.Package,
+			Filename:        f.File,
+			AbsPath:         f.Path,
+			Lineno:          f.Line,
+			Colno:           f.Column,
+			InApp:           f.InApp,
+			InstructionAddr: f.InstructionAddr,
+			Lang:            f.Lang,
+			Platform:        string(f.Platform),
+			Status:          f.Status,
+			SymAddr:         f.SymAddr,
+			Symbol:          f.Symbol,
+			Data: &vroompb.FrameData{
+				DeobfuscationStatus: f.Data.DeobfuscationStatus,
+				SymbolicatorStatus:  f.Data.SymbolicatorStatus,
+				Symbolicated:        f.Data.JsSymbolicated,
+			},
+		})
+	}
+	for _, s := range d.Samples {
+		pb.Samples = append(pb.Samples, &vroompb.ChunkSample{
+			StackId:   int32(s.StackID),
+			ThreadId:  s.ThreadID,
+			Timestamp: s.Timestamp,
+		})
+	}
+	for _, stack := range d.Stacks {
+		values := make([]int32, 0, len(stack))
+		for _, frameID := range stack {
+			values = append(values, int32(frameID))
+		}
+		pb.Stacks = append(pb.Stacks, &vroompb.Indices{Values: values})
+	}
+	if len(d.ThreadMetadata) > 0 {
+		pb.ThreadMetadata = make(map[string]*vroompb.ThreadMetadata, len(d.ThreadMetadata))
+		for threadID, m := range d.ThreadMetadata {
+			pb.ThreadMetadata[threadID] = &vroompb.ThreadMetadata{
+				Name:     m.Name,
+				Priority: int32(m.Priority),
+			}
+		}
+	}
+	return pb
+}
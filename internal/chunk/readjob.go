@@ -52,6 +52,20 @@ func (job ReadJob) Read() {
 	}
 }
 
+func (job ReadJob) Context() context.Context {
+	return job.Ctx
+}
+
+func (job ReadJob) Skip(err error) {
+	job.Result <- ReadJobResult{
+		Err:           err,
+		TransactionID: job.TransactionID,
+		ThreadID:      job.ThreadID,
+		Start:         job.Start,
+		End:           job.End,
+	}
+}
+
 func (result ReadJobResult) Error() error {
 	return result.Err
 }
@@ -0,0 +1,56 @@
+package chunk
+
+import (
+	"github.com/getsentry/vroom/internal/jsonutil"
+)
+
+// WriteJSON streams the chunk in the same format as encoding/json, writing
+// frames, samples and stacks one at a time. It has to be kept in sync with
+// the fields of Chunk.
+func (c Chunk) WriteJSON(sw *jsonutil.StreamWriter) {
+	sw.BeginObject()
+	sw.Field("chunk_id", c.ID)
+	sw.Field("profiler_id", c.ProfilerID)
+	sw.Field("debug_meta", c.DebugMeta)
+	sw.Field("environment", c.Environment)
+	sw.Field("platform", c.Platform)
+	sw.Field("release", c.Release)
+	sw.Field("version", c.Version)
+	sw.Key("profile")
+	c.Profile.WriteJSON(sw)
+	sw.Field("organization_id", c.OrganizationID)
+	sw.Field("project_id", c.ProjectID)
+	sw.Field("received", c.Received)
+	sw.Field("retention_days", c.RetentionDays)
+	sw.Field("measurements", c.Measurements)
+	sw.Field("options", c.Options)
+	sw.EndObject()
+}
+
+func (d Data) WriteJSON(sw *jsonutil.StreamWriter) {
+	sw.BeginObject()
+	sw.Key("frames")
+	if d.Frames == nil {
+		sw.Null()
+	} else {
+		sw.BeginArray()
+		for _, f := range d.Frames {
+			sw.Value(f)
+		}
+		sw.EndArray()
+	}
+	sw.Key("samples")
+	if d.Samples == nil {
+		sw.Null()
+	} else {
+		sw.BeginArray()
+		for _, s := range d.Samples {
+			sw.Value(s)
+		}
+		sw.EndArray()
+	}
+	sw.Key("stacks")
+	sw.IntSlices(d.Stacks)
+	sw.Field("thread_metadata", d.ThreadMetadata)
+	sw.EndObject()
+}
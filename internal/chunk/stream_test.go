@@ -0,0 +1,73 @@
+package chunk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/getsentry/vroom/internal/frame"
+	"github.com/getsentry/vroom/internal/jsonutil"
+	"github.com/getsentry/vroom/internal/platform"
+	"github.com/getsentry/vroom/internal/sample"
+)
+
+func TestChunkWriteJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		chunk Chunk
+	}{
+		{
+			name:  "empty",
+			chunk: Chunk{},
+		},
+		{
+			name: "chunk with samples",
+			chunk: Chunk{
+				ID:          "a",
+				ProfilerID:  "b",
+				Environment: "production",
+				Platform:    platform.Python,
+				Release:     "1.0",
+				Version:     "2",
+				Profile: Data{
+					Frames: []frame.Frame{
+						{Function: "function0", Module: "m"},
+						{Function: "function1"},
+					},
+					Samples: []Sample{
+						{StackID: 0, Timestamp: 0.010, ThreadID: "1"},
+						{StackID: 1, Timestamp: 1e21, ThreadID: "1"},
+					},
+					Stacks: [][]int{{0}, {1, 0}},
+					ThreadMetadata: map[string]sample.ThreadMetadata{
+						"1": {Name: "main"},
+					},
+				},
+				OrganizationID: 1,
+				ProjectID:      2,
+				Received:       1.5,
+				RetentionDays:  90,
+				Measurements:   json.RawMessage(`{"a":1}`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expected, err := json.Marshal(test.chunk)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var b bytes.Buffer
+			sw := jsonutil.NewStreamWriter(context.Background(), &b)
+			test.chunk.WriteJSON(sw)
+			if err := sw.Flush(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !bytes.Equal(expected, b.Bytes()) {
+				t.Fatalf("expected %s, got %s", expected, b.Bytes())
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package chunk
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/getsentry/vroom/internal/sample"
+)
+
+// Validate checks the chunk is within the limits and consistent before it's
+// stored. Samples have to reference existing stacks and have a valid
+// timestamp, they don't have to be ordered by time since they're sorted when
+// they're read.
+func (c *Chunk) Validate(limits sample.Limits) error {
+	d := c.Profile
+	if err := limits.CheckCounts(len(d.Samples), len(d.Frames), 0); err != nil {
+		return err
+	}
+	threads := make(map[string]struct{})
+	for i, s := range d.Samples {
+		if s.StackID < 0 || s.StackID >= len(d.Stacks) {
+			return invalidf("sample %d references stack %d out of %d stacks", i, s.StackID, len(d.Stacks))
+		}
+		if math.IsNaN(s.Timestamp) || math.IsInf(s.Timestamp, 0) || s.Timestamp < 0 {
+			return invalidf("sample %d has an invalid timestamp", i)
+		}
+		threads[s.ThreadID] = struct{}{}
+	}
+	if err := limits.CheckCounts(0, 0, len(threads)); err != nil {
+		return err
+	}
+	return sample.CheckStacks(d.Stacks, len(d.Frames))
+}
+
+func invalidf(format string, args ...interface{}) error {
+	return &sample.ValidationError{Reason: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,102 @@
+package chunk
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/getsentry/vroom/internal/frame"
+	"github.com/getsentry/vroom/internal/sample"
+)
+
+func TestValidate(t *testing.T) {
+	limits := sample.Limits{MaxSamples: 4, MaxFrames: 2, MaxThreads: 2}
+	tests := []struct {
+		name    string
+		data    Data
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			data: Data{
+				Frames: []frame.Frame{{Function: "a"}, {Function: "b"}},
+				Stacks: [][]int{{0}, {1, 0}},
+				Samples: []Sample{
+					{StackID: 0, ThreadID: "1", Timestamp: 2.0},
+					{StackID: 1, ThreadID: "2", Timestamp: 1.0},
+					{StackID: 1, ThreadID: "1", Timestamp: 2.5},
+				},
+			},
+		},
+		{
+			name: "too many threads",
+			data: Data{
+				Frames:  []frame.Frame{{Function: "a"}},
+				Stacks:  [][]int{{0}},
+				Samples: []Sample{{ThreadID: "1"}, {ThreadID: "2"}, {ThreadID: "3"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "stack out of bounds",
+			data: Data{
+				Frames:  []frame.Frame{{Function: "a"}},
+				Stacks:  [][]int{{0}},
+				Samples: []Sample{{StackID: -1}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "frame out of bounds",
+			data: Data{
+				Frames:  []frame.Frame{{Function: "a"}},
+				Stacks:  [][]int{{2}},
+				Samples: []Sample{{StackID: 0}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid timestamp",
+			data: Data{
+				Frames:  []frame.Frame{{Function: "a"}},
+				Stacks:  [][]int{{0}},
+				Samples: []Sample{{Timestamp: math.NaN()}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative timestamp",
+			data: Data{
+				Frames:  []frame.Frame{{Function: "a"}},
+				Stacks:  [][]int{{0}},
+				Samples: []Sample{{Timestamp: -1}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unordered timestamps",
+			data: Data{
+				Frames:  []frame.Frame{{Function: "a"}},
+				Stacks:  [][]int{{0}},
+				Samples: []Sample{{ThreadID: "1", Timestamp: 2}, {ThreadID: "1", Timestamp: 1}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := Chunk{Profile: test.data}
+			err := c.Validate(limits)
+			if !test.wantErr {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var validationErr *sample.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected a validation error, got %v", err)
+			}
+		})
+	}
+}
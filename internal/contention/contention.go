@@ -0,0 +1,326 @@
+package contention
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/getsentry/vroom/internal/frame"
+	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/platform"
+)
+
+type (
+	// Kind is the kind of primitive a thread is blocked on.
+	Kind string
+
+	Function struct {
+		Fingerprint uint32 `json:"fingerprint"`
+		Function    string `json:"function"`
+		Package     string `json:"package"`
+	}
+
+	// Wait is the time spent waiting on a primitive, attributed to the
+	// closest in-app function calling it.
+	Wait struct {
+		ActiveThreadWaitNS uint64   `json:"active_thread_wait_ns"`
+		Caller             Function `json:"caller"`
+		Kind               Kind     `json:"kind"`
+		Primitive          Function `json:"primitive"`
+		SampleCount        int      `json:"sample_count"`
+		WaitNS             uint64   `json:"wait_ns"`
+	}
+
+	ThreadWait struct {
+		Active     bool   `json:"active"`
+		DurationNS uint64 `json:"duration_ns"`
+		ThreadID   uint64 `json:"thread_id"`
+		WaitNS     uint64 `json:"wait_ns"`
+	}
+
+	// Summary is the time spent waiting rather than running in a profile.
+	Summary struct {
+		ActiveThreadDurationNS uint64       `json:"active_thread_duration_ns"`
+		ActiveThreadWaitNS     uint64       `json:"active_thread_wait_ns"`
+		Threads                []ThreadWait `json:"threads"`
+		TotalWaitNS            uint64       `json:"total_wait_ns"`
+		Waits                  []Wait       `json:"waits"`
+	}
+
+	waitKey struct {
+		caller    uint32
+		primitive uint32
+	}
+)
+
+const (
+	Condition Kind = "condition"
+	Join      Kind = "join"
+	Lock      Kind = "lock"
+	Semaphore Kind = "semaphore"
+	Sleep     Kind = "sleep"
+
+	// anyPackage matches a function regardless of its package, for platforms
+	// where the package is the binary holding the function.
+	anyPackage = "*"
+
+	maxWaits = 50
+)
+
+var waitPrimitivesByPlatform = map[platform.Platform]map[string]map[string]Kind{
+	platform.Android: javaWaitPrimitives,
+	platform.Cocoa: {
+		"libdispatch": {
+			"dispatch_group_wait":     Join,
+			"dispatch_semaphore_wait": Semaphore,
+		},
+		"libsystem_c": {
+			"nanosleep": Sleep,
+			"sleep":     Sleep,
+			"usleep":    Sleep,
+		},
+		"libsystem_kernel": {
+			"__psynch_cvwait":     Condition,
+			"__psynch_mutexwait":  Lock,
+			"__psynch_rw_rdlock":  Lock,
+			"__psynch_rw_wrlock":  Lock,
+			"__semwait_signal":    Sleep,
+			"__ulock_wait":        Lock,
+			"__ulock_wait2":       Lock,
+			"semaphore_wait_trap": Semaphore,
+		},
+		"libsystem_platform": {
+			"_os_unfair_lock_lock_slow": Lock,
+			"os_unfair_lock_lock":       Lock,
+		},
+		"libsystem_pthread": {
+			"pthread_cond_timedwait": Condition,
+			"pthread_cond_wait":      Condition,
+			"pthread_join":           Join,
+			"pthread_mutex_lock":     Lock,
+			"pthread_rwlock_rdlock":  Lock,
+			"pthread_rwlock_wrlock":  Lock,
+		},
+		"Foundation": {
+			"-[NSCondition wait]":                   Condition,
+			"-[NSCondition waitUntilDate:]":         Condition,
+			"-[NSConditionLock lockWhenCondition:]": Lock,
+			"-[NSLock lock]":                        Lock,
+			"-[NSRecursiveLock lock]":               Lock,
+			"+[NSThread sleepForTimeInterval:]":     Sleep,
+			"+[NSThread sleepUntilDate:]":           Sleep,
+		},
+	},
+	platform.Java: javaWaitPrimitives,
+	platform.JavaScript: {
+		anyPackage: {
+			"Atomics.wait": Lock,
+		},
+	},
+	platform.Node: {
+		anyPackage: {
+			"Atomics.wait": Lock,
+		},
+	},
+	platform.PHP: {
+		anyPackage: {
+			"flock":          Lock,
+			"sem_acquire":    Semaphore,
+			"sleep":          Sleep,
+			"time_nanosleep": Sleep,
+			"usleep":         Sleep,
+		},
+	},
+	platform.Python: {
+		"_thread": {
+			"lock.acquire": Lock,
+		},
+		"concurrent.futures._base": {
+			"Future.result": Join,
+			"result":        Join,
+			"wait":          Join,
+		},
+		"queue": {
+			"Queue.get": Condition,
+			"Queue.put": Condition,
+		},
+		"threading": {
+			"Barrier.wait":      Condition,
+			"Condition.wait":    Condition,
+			"Event.wait":        Condition,
+			"Lock.acquire":      Lock,
+			"RLock.acquire":     Lock,
+			"Semaphore.acquire": Semaphore,
+			"Thread.join":       Join,
+			"acquire":           Lock,
+			"join":              Join,
+			"wait":              Condition,
+		},
+	},
+	platform.Rust: {
+		anyPackage: {
+			"std::sync::condvar::Condvar::wait":                         Condition,
+			"std::sys::sync::mutex::futex::Mutex::lock_contended":       Lock,
+			"std::sys::unix::locks::futex_mutex::Mutex::lock_contended": Lock,
+			"std::thread::JoinInner<T>::join":                           Join,
+			"std::thread::park":                                         Lock,
+			"std::thread::sleep":                                        Sleep,
+		},
+	},
+}
+
+var javaWaitPrimitives = map[string]map[string]Kind{
+	"java.lang": {
+		"java.lang.Object.wait":  Condition,
+		"java.lang.Thread.join":  Join,
+		"java.lang.Thread.sleep": Sleep,
+	},
+	"java.util.concurrent": {
+		"java.util.concurrent.CountDownLatch.await":   Condition,
+		"java.util.concurrent.CyclicBarrier.await":    Condition,
+		"java.util.concurrent.FutureTask.get":         Join,
+		"java.util.concurrent.Semaphore.acquire":      Semaphore,
+		"java.util.concurrent.CompletableFuture.get":  Join,
+		"java.util.concurrent.CompletableFuture.join": Join,
+	},
+	"java.util.concurrent.locks": {
+		"java.util.concurrent.locks.LockSupport.park":                      Lock,
+		"java.util.concurrent.locks.LockSupport.parkNanos":                 Lock,
+		"java.util.concurrent.locks.ReentrantLock.lock":                    Lock,
+		"java.util.concurrent.locks.ReentrantReadWriteLock$ReadLock.lock":  Lock,
+		"java.util.concurrent.locks.ReentrantReadWriteLock$WriteLock.lock": Lock,
+	},
+	"kotlinx.coroutines": {
+		"kotlinx.coroutines.BlockingCoroutine.joinBlocking": Join,
+	},
+}
+
+// waitKind returns the kind of primitive the node is waiting on, if any.
+func waitKind(primitives map[string]map[string]Kind, n *nodetree.Node) (Kind, bool) {
+	// Java frame names contain the deobfuscated signature, we only match on
+	// the function name.
+	name, _, _ := strings.Cut(n.Name, "(")
+	if functions, exists := primitives[n.Package]; exists {
+		if kind, exists := functions[name]; exists {
+			return kind, true
+		}
+	}
+	if functions, exists := primitives[anyPackage]; exists {
+		if kind, exists := functions[name]; exists {
+			return kind, true
+		}
+	}
+	return "", false
+}
+
+// Summarize finds all the time threads spent blocked on a known wait
+// primitive and attributes it to the closest in-app caller.
+func Summarize(
+	p platform.Platform,
+	callTreesPerThreadID map[uint64][]*nodetree.Node,
+	activeThreadID uint64,
+) Summary {
+	s := Summary{
+		Threads: []ThreadWait{},
+		Waits:   []Wait{},
+	}
+	primitives, exists := waitPrimitivesByPlatform[p]
+	if !exists {
+		return s
+	}
+	waits := make(map[waitKey]*Wait)
+	for threadID, callTrees := range callTreesPerThreadID {
+		t := ThreadWait{
+			Active:   threadID == activeThreadID,
+			ThreadID: threadID,
+		}
+		for _, root := range callTrees {
+			t.DurationNS += root.DurationNS
+			t.WaitNS += collectWaits(primitives, root, nil, t.Active, waits)
+		}
+		if t.Active {
+			s.ActiveThreadDurationNS = t.DurationNS
+			s.ActiveThreadWaitNS = t.WaitNS
+		}
+		if t.WaitNS == 0 {
+			continue
+		}
+		s.TotalWaitNS += t.WaitNS
+		s.Threads = append(s.Threads, t)
+	}
+	sort.SliceStable(s.Threads, func(i, j int) bool {
+		if s.Threads[i].Active != s.Threads[j].Active {
+			return s.Threads[i].Active
+		}
+		if s.Threads[i].WaitNS == s.Threads[j].WaitNS {
+			return s.Threads[i].ThreadID < s.Threads[j].ThreadID
+		}
+		return s.Threads[i].WaitNS > s.Threads[j].WaitNS
+	})
+	for _, w := range waits {
+		s.Waits = append(s.Waits, *w)
+	}
+	sort.SliceStable(s.Waits, func(i, j int) bool {
+		if s.Waits[i].WaitNS == s.Waits[j].WaitNS {
+			if s.Waits[i].Caller.Fingerprint == s.Waits[j].Caller.Fingerprint {
+				return s.Waits[i].Primitive.Fingerprint < s.Waits[j].Primitive.Fingerprint
+			}
+			return s.Waits[i].Caller.Fingerprint < s.Waits[j].Caller.Fingerprint
+		}
+		return s.Waits[i].WaitNS > s.Waits[j].WaitNS
+	})
+	if len(s.Waits) > maxWaits {
+		s.Waits = s.Waits[:maxWaits]
+	}
+	return s
+}
+
+// collectWaits returns the time spent waiting under the node. We stop at the
+// first primitive found on a branch since everything below is part of the wait.
+func collectWaits(
+	primitives map[string]map[string]Kind,
+	n *nodetree.Node,
+	caller *nodetree.Node,
+	active bool,
+	waits map[waitKey]*Wait,
+) uint64 {
+	if kind, exists := waitKind(primitives, n); exists {
+		primitive := newFunction(n.Frame)
+		key := waitKey{primitive: primitive.Fingerprint}
+		if caller != nil {
+			key.caller = caller.Frame.Fingerprint()
+		}
+		w, exists := waits[key]
+		if !exists {
+			w = &Wait{
+				Kind:      kind,
+				Primitive: primitive,
+			}
+			if caller != nil {
+				w.Caller = newFunction(caller.Frame)
+			}
+			waits[key] = w
+		}
+		w.SampleCount += n.SampleCount
+		w.WaitNS += n.DurationNS
+		if active {
+			w.ActiveThreadWaitNS += n.DurationNS
+		}
+		return n.DurationNS
+	}
+	if n.IsApplication {
+		caller = n
+	}
+	var waitNS uint64
+	for _, c := range n.Children {
+		waitNS += collectWaits(primitives, c, caller, active, waits)
+	}
+	return waitNS
+}
+
+func newFunction(f frame.Frame) Function {
+	return Function{
+		Fingerprint: f.Fingerprint(),
+		Function:    f.Function,
+		Package:     f.ModuleOrPackage(),
+	}
+}
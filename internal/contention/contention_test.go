@@ -0,0 +1,151 @@
+package contention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getsentry/vroom/internal/frame"
+	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/platform"
+	"github.com/getsentry/vroom/internal/testutil"
+)
+
+func newNode(pkg, function string, inApp bool, start, end time.Duration, children ...*nodetree.Node) *nodetree.Node {
+	f := frame.Frame{
+		Function: function,
+		InApp:    &inApp,
+		Package:  pkg,
+	}
+	n := nodetree.NodeFromFrame(f, uint64(start), uint64(end), 0)
+	n.SampleCount = int((end - start) / (10 * time.Millisecond))
+	n.Children = children
+	return n
+}
+
+func TestSummarize(t *testing.T) {
+	viewDidLoad := frame.Frame{Function: "viewDidLoad", Package: "App"}
+	worker := frame.Frame{Function: "worker", Package: "App"}
+	mutexLock := frame.Frame{Function: "pthread_mutex_lock", Package: "libsystem_pthread.dylib"}
+	conditionWait := frame.Frame{Function: "-[NSCondition wait]", Package: "Foundation"}
+	semaphoreWait := frame.Frame{Function: "dispatch_semaphore_wait", Package: "libdispatch.dylib"}
+	objectWait := frame.Frame{Function: "java.lang.Object.wait()", Package: "java.lang"}
+	refresh := frame.Frame{Function: "com.example.Cache.refresh()", Package: "com.example"}
+
+	tests := []struct {
+		name      string
+		platform  platform.Platform
+		callTrees map[uint64][]*nodetree.Node
+		want      Summary
+	}{
+		{
+			name:     "Cocoa waits on main and background threads",
+			platform: platform.Cocoa,
+			callTrees: map[uint64][]*nodetree.Node{
+				1: {
+					newNode("UIKitCore", "UIApplicationMain", false, 0, time.Second,
+						newNode("App", "viewDidLoad", true, 0, time.Second,
+							newNode("libsystem_pthread.dylib", "pthread_mutex_lock", false, 0, 300*time.Millisecond,
+								newNode("libsystem_kernel.dylib", "__psynch_mutexwait", false, 0, 300*time.Millisecond),
+							),
+						),
+					),
+				},
+				2: {
+					newNode("App", "worker", true, 0, time.Second,
+						newNode("Foundation", "-[NSCondition wait]", false, 0, 800*time.Millisecond),
+					),
+				},
+				3: {
+					newNode("libdispatch.dylib", "dispatch_semaphore_wait", false, 0, 100*time.Millisecond),
+				},
+				4: {
+					newNode("App", "compute", true, 0, time.Second),
+				},
+			},
+			want: Summary{
+				ActiveThreadDurationNS: uint64(time.Second),
+				ActiveThreadWaitNS:     uint64(300 * time.Millisecond),
+				Threads: []ThreadWait{
+					{Active: true, DurationNS: uint64(time.Second), ThreadID: 1, WaitNS: uint64(300 * time.Millisecond)},
+					{DurationNS: uint64(time.Second), ThreadID: 2, WaitNS: uint64(800 * time.Millisecond)},
+					{DurationNS: uint64(100 * time.Millisecond), ThreadID: 3, WaitNS: uint64(100 * time.Millisecond)},
+				},
+				TotalWaitNS: uint64(1200 * time.Millisecond),
+				Waits: []Wait{
+					{
+						Caller:      newFunction(worker),
+						Kind:        Condition,
+						Primitive:   newFunction(conditionWait),
+						SampleCount: 80,
+						WaitNS:      uint64(800 * time.Millisecond),
+					},
+					{
+						ActiveThreadWaitNS: uint64(300 * time.Millisecond),
+						Caller:             newFunction(viewDidLoad),
+						Kind:               Lock,
+						Primitive:          newFunction(mutexLock),
+						SampleCount:        30,
+						WaitNS:             uint64(300 * time.Millisecond),
+					},
+					{
+						Kind:        Semaphore,
+						Primitive:   newFunction(semaphoreWait),
+						SampleCount: 10,
+						WaitNS:      uint64(100 * time.Millisecond),
+					},
+				},
+			},
+		},
+		{
+			name:     "Android wait with a signature",
+			platform: platform.Android,
+			callTrees: map[uint64][]*nodetree.Node{
+				1: {
+					newNode("com.example", "com.example.Cache.refresh()", true, 0, 500*time.Millisecond,
+						newNode("java.lang", "java.lang.Object.wait()", false, 100*time.Millisecond, 500*time.Millisecond),
+					),
+				},
+			},
+			want: Summary{
+				ActiveThreadDurationNS: uint64(500 * time.Millisecond),
+				ActiveThreadWaitNS:     uint64(400 * time.Millisecond),
+				Threads: []ThreadWait{
+					{Active: true, DurationNS: uint64(500 * time.Millisecond), ThreadID: 1, WaitNS: uint64(400 * time.Millisecond)},
+				},
+				TotalWaitNS: uint64(400 * time.Millisecond),
+				Waits: []Wait{
+					{
+						ActiveThreadWaitNS: uint64(400 * time.Millisecond),
+						Caller:             newFunction(refresh),
+						Kind:               Condition,
+						Primitive:          newFunction(objectWait),
+						SampleCount:        40,
+						WaitNS:             uint64(400 * time.Millisecond),
+					},
+				},
+			},
+		},
+		{
+			name:     "Unsupported platform",
+			platform: platform.Platform("unknown"),
+			callTrees: map[uint64][]*nodetree.Node{
+				1: {
+					newNode("libsystem_pthread.dylib", "pthread_mutex_lock", false, 0, time.Second),
+				},
+			},
+			want: Summary{
+				Threads: []ThreadWait{},
+				Waits:   []Wait{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Summarize(tt.platform, tt.callTrees, 1)
+			if diff := testutil.Diff(s, tt.want); diff != "" {
+				t.Fatalf("Result mismatch: got - want +\n%s", diff)
+			}
+		})
+	}
+}
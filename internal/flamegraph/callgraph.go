@@ -0,0 +1,234 @@
+package flamegraph
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/getsentry/vroom/internal/chunk"
+	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/profile"
+	"github.com/getsentry/vroom/internal/storageutil"
+	"github.com/getsentry/vroom/internal/utils"
+	"gocloud.dev/blob"
+)
+
+type (
+	CallgraphFunction struct {
+		Fingerprint uint32 `json:"fingerprint"`
+		Function    string `json:"function"`
+		Package     string `json:"package"`
+		InApp       bool   `json:"in_app"`
+		SampleCount int    `json:"sample_count"`
+		DurationNS  uint64 `json:"duration_ns"`
+	}
+
+	// Callgraph holds the distinct callers and callees of a function. The
+	// sample count and duration of a caller are the ones of the function
+	// when called by it.
+	Callgraph struct {
+		Function CallgraphFunction   `json:"function"`
+		Callers  []CallgraphFunction `json:"callers"`
+		Callees  []CallgraphFunction `json:"callees"`
+	}
+
+	CallgraphAggregator struct {
+		fingerprint uint32
+		function    *CallgraphFunction
+		callers     map[uint32]*CallgraphFunction
+		callees     map[uint32]*CallgraphFunction
+	}
+)
+
+func NewCallgraphAggregator(fingerprint uint32) *CallgraphAggregator {
+	return &CallgraphAggregator{
+		fingerprint: fingerprint,
+		callers:     make(map[uint32]*CallgraphFunction),
+		callees:     make(map[uint32]*CallgraphFunction),
+	}
+}
+
+func newCallgraphFunction(n *nodetree.Node, fingerprint uint32) *CallgraphFunction {
+	return &CallgraphFunction{
+		Fingerprint: fingerprint,
+		Function:    n.Frame.Function,
+		Package:     n.Frame.ModuleOrPackage(),
+		InApp:       n.IsApplication,
+	}
+}
+
+func addToCallgraphFunctions(functions map[uint32]*CallgraphFunction, n *nodetree.Node, sampleCount int, durationNS uint64) {
+	fingerprint := n.Frame.Fingerprint()
+	f, exists := functions[fingerprint]
+	if !exists {
+		f = newCallgraphFunction(n, fingerprint)
+		functions[fingerprint] = f
+	}
+	f.SampleCount += sampleCount
+	f.DurationNS += durationNS
+}
+
+// AddCallTree adds the callers and callees of every node matching the
+// fingerprint in the call tree.
+func (a *CallgraphAggregator) AddCallTree(callTree []*nodetree.Node) {
+	for _, root := range callTree {
+		a.visit(root, nil, false)
+	}
+}
+
+// visit walks the tree. matched is true when an ancestor matches the
+// fingerprint, so recursive calls aren't counted twice in the function total.
+func (a *CallgraphAggregator) visit(n *nodetree.Node, parent *nodetree.Node, matched bool) {
+	isMatch := n.Frame.Fingerprint() == a.fingerprint
+	if isMatch {
+		if a.function == nil {
+			a.function = newCallgraphFunction(n, a.fingerprint)
+		}
+		if !matched {
+			a.function.SampleCount += n.SampleCount
+			a.function.DurationNS += n.DurationNS
+		}
+		if parent != nil {
+			addToCallgraphFunctions(a.callers, parent, n.SampleCount, n.DurationNS)
+		}
+		for _, child := range n.Children {
+			addToCallgraphFunctions(a.callees, child, child.SampleCount, child.DurationNS)
+		}
+	}
+	for _, child := range n.Children {
+		a.visit(child, n, matched || isMatch)
+	}
+}
+
+func sortedCallgraphFunctions(functions map[uint32]*CallgraphFunction) []CallgraphFunction {
+	sorted := make([]CallgraphFunction, 0, len(functions))
+	for _, f := range functions {
+		sorted = append(sorted, *f)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].DurationNS == sorted[j].DurationNS {
+			return sorted[i].Fingerprint < sorted[j].Fingerprint
+		}
+		return sorted[i].DurationNS > sorted[j].DurationNS
+	})
+	return sorted
+}
+
+// Callgraph returns the callers and callees sorted by descending duration.
+// The function is empty if it wasn't found.
+func (a *CallgraphAggregator) Callgraph() Callgraph {
+	c := Callgraph{
+		Function: CallgraphFunction{Fingerprint: a.fingerprint},
+		Callers:  sortedCallgraphFunctions(a.callers),
+		Callees:  sortedCallgraphFunctions(a.callees),
+	}
+	if a.function != nil {
+		c.Function = *a.function
+	}
+	return c
+}
+
+func GetCallgraphFromCandidates(
+	ctx context.Context,
+	storage *blob.Bucket,
+	organizationID uint64,
+	fingerprint uint32,
+	transactionProfileCandidates []utils.TransactionProfileCandidate,
+	continuousProfileCandidates []utils.ContinuousProfileCandidate,
+	jobs *storageutil.Scheduler,
+) (Callgraph, error) {
+	hub := sentry.GetHubFromContext(ctx)
+
+	numCandidates := len(transactionProfileCandidates) + len(continuousProfileCandidates)
+
+	results := make(chan storageutil.ReadJobResult, numCandidates)
+	batch := make([]storageutil.ReadJob, 0, cap(results))
+
+	for _, candidate := range transactionProfileCandidates {
+		batch = append(batch, profile.ReadJob{
+			Ctx:            ctx,
+			OrganizationID: organizationID,
+			ProjectID:      candidate.ProjectID,
+			ProfileID:      candidate.ProfileID,
+			Storage:        storage,
+			Result:         results,
+		})
+	}
+
+	for _, candidate := range continuousProfileCandidates {
+		batch = append(batch, chunk.ReadJob{
+			Ctx:            ctx,
+			OrganizationID: organizationID,
+			ProjectID:      candidate.ProjectID,
+			ProfilerID:     candidate.ProfilerID,
+			ChunkID:        candidate.ChunkID,
+			TransactionID:  candidate.TransactionID,
+			ThreadID:       candidate.ThreadID,
+			Start:          candidate.Start,
+			End:            candidate.End,
+			Storage:        storage,
+			Result:         results,
+		})
+	}
+
+	if err := jobs.Submit(ctx, batch...); err != nil {
+		return Callgraph{}, err
+	}
+
+	a := NewCallgraphAggregator(fingerprint)
+
+	for i := 0; i < numCandidates; i++ {
+		res := <-results
+
+		err := res.Error()
+		if err != nil {
+			if errors.Is(err, storageutil.ErrObjectNotFound) {
+				continue
+			}
+			if errors.Is(err, context.DeadlineExceeded) {
+				return Callgraph{}, err
+			}
+			if hub != nil {
+				hub.CaptureException(err)
+			}
+			continue
+		}
+
+		if result, ok := res.(profile.ReadJobResult); ok {
+			profileCallTrees, err := result.Profile.CallTrees()
+			if err != nil {
+				if hub != nil {
+					hub.CaptureException(err)
+				}
+				continue
+			}
+			for _, callTree := range profileCallTrees {
+				a.AddCallTree(callTree)
+			}
+		} else if result, ok := res.(chunk.ReadJobResult); ok {
+			chunkCallTrees, err := result.Chunk.CallTrees(result.ThreadID)
+			if err != nil {
+				if hub != nil {
+					hub.CaptureException(err)
+				}
+				continue
+			}
+			for _, callTree := range chunkCallTrees {
+				if result.Start > 0 && result.End > 0 {
+					interval := utils.Interval{
+						Start: result.Start,
+						End:   result.End,
+					}
+					callTree = sliceCallTree(&callTree, &[]utils.Interval{interval})
+				}
+				a.AddCallTree(callTree)
+			}
+		} else {
+			// This should never happen
+			return Callgraph{}, errors.New("unexpected result from storage")
+		}
+	}
+
+	return a.Callgraph(), nil
+}
@@ -0,0 +1,80 @@
+package flamegraph
+
+import (
+	"testing"
+
+	"github.com/getsentry/vroom/internal/frame"
+	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/testutil"
+)
+
+func newCallgraphNode(function string, inApp bool, sampleCount int, durationNS uint64, children ...*nodetree.Node) *nodetree.Node {
+	return &nodetree.Node{
+		Children:      children,
+		DurationNS:    durationNS,
+		IsApplication: inApp,
+		Frame:         frame.Frame{Function: function, Package: "pkg"},
+		SampleCount:   sampleCount,
+	}
+}
+
+func TestCallgraphAggregator(t *testing.T) {
+	fingerprint := func(function string) uint32 {
+		return frame.Frame{Function: function, Package: "pkg"}.Fingerprint()
+	}
+	callTrees := [][]*nodetree.Node{
+		{
+			newCallgraphNode("main", true, 4, 40,
+				newCallgraphNode("a", true, 3, 30,
+					newCallgraphNode("target", true, 3, 30,
+						newCallgraphNode("leaf", false, 1, 10),
+						// recursive call
+						newCallgraphNode("target", true, 2, 20),
+					),
+				),
+			),
+		},
+		{
+			newCallgraphNode("main", true, 2, 20,
+				newCallgraphNode("b", true, 2, 20,
+					newCallgraphNode("target", true, 2, 20,
+						newCallgraphNode("leaf", false, 2, 20),
+					),
+				),
+			),
+		},
+	}
+
+	a := NewCallgraphAggregator(fingerprint("target"))
+	for _, callTree := range callTrees {
+		a.AddCallTree(callTree)
+	}
+
+	want := Callgraph{
+		Function: CallgraphFunction{
+			Fingerprint: fingerprint("target"),
+			Function:    "target",
+			Package:     "pkg",
+			InApp:       true,
+			SampleCount: 5,
+			DurationNS:  50,
+		},
+		Callers: []CallgraphFunction{
+			{Fingerprint: fingerprint("a"), Function: "a", Package: "pkg", InApp: true, SampleCount: 3, DurationNS: 30},
+			{Fingerprint: fingerprint("b"), Function: "b", Package: "pkg", InApp: true, SampleCount: 2, DurationNS: 20},
+			{Fingerprint: fingerprint("target"), Function: "target", Package: "pkg", InApp: true, SampleCount: 2, DurationNS: 20},
+		},
+		Callees: []CallgraphFunction{
+			{Fingerprint: fingerprint("leaf"), Function: "leaf", Package: "pkg", SampleCount: 3, DurationNS: 30},
+			{Fingerprint: fingerprint("target"), Function: "target", Package: "pkg", InApp: true, SampleCount: 2, DurationNS: 20},
+		},
+	}
+	// Callers and callees with the same duration are sorted by fingerprint.
+	if fingerprint("b") > fingerprint("target") {
+		want.Callers[1], want.Callers[2] = want.Callers[2], want.Callers[1]
+	}
+
+	if diff := testutil.Diff(a.Callgraph(), want); diff != "" {
+		t.Fatalf("Result mismatch: got - want +\n%s", diff)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -48,7 +49,8 @@ func GetFlamegraphFromProfiles(
 	profileIDs []string,
 	spans *[][]utils.Interval,
 	numWorkers int,
-	timeout time.Duration) (speedscope.Output, error) {
+	timeout time.Duration,
+	options Options) (speedscope.Output, error) {
 	if numWorkers < 1 {
 		numWorkers = 1
 	}
@@ -130,12 +132,12 @@ func GetFlamegraphFromProfiles(
 	for pair := range callTreesQueue {
 		profileID := pair.First
 		for _, callTree := range pair.Second {
-			addCallTreeToFlamegraph(&flamegraphTree, callTree, annotateWithProfileID(profileID))
+			options.addCallTree(&flamegraphTree, callTree, annotateWithProfileID(profileID))
 		}
 		countProfAggregated++
 	}
 
-	sp := toSpeedscope(flamegraphTree, 4, projectID)
+	sp := toSpeedscope(flamegraphTree, options, projectID)
 	hub.Scope().SetTag("processed_profiles", strconv.Itoa(countProfAggregated))
 	return sp, nil
 }
@@ -224,13 +226,18 @@ type flamegraph struct {
 	profiles          []utils.ExampleMetadata
 	endValue          uint64
 	minFreq           int
+	minDurationNS     uint64
+	maxDepth          int
+	truncation        speedscope.Truncation
 }
 
-func toSpeedscope(trees []*nodetree.Node, minFreq int, projectID uint64) speedscope.Output {
+func toSpeedscope(trees []*nodetree.Node, options Options, projectID uint64) speedscope.Output {
 	fd := &flamegraph{
 		frames:           make([]speedscope.Frame, 0),
 		framesIndex:      make(map[string]int),
-		minFreq:          minFreq,
+		minFreq:          options.MinSampleCount,
+		minDurationNS:    options.MinDurationNS,
+		maxDepth:         options.MaxDepth,
 		profilesIDsIndex: make(map[string]int),
 		profilesIndex:    make(map[utils.ExampleMetadata]int),
 		samples:          make([][]int, 0),
@@ -266,7 +273,8 @@ func toSpeedscope(trees []*nodetree.Node, minFreq int, projectID uint64) speedsc
 			ProfileIDs: fd.profilesIDs,
 			Profiles:   fd.profiles,
 		},
-		Profiles: aggProfiles,
+		Profiles:   aggProfiles,
+		Truncation: &fd.truncation,
 	}
 }
 
@@ -275,8 +283,38 @@ func getIDFromNode(node *nodetree.Node) string {
 	return hex.EncodeToString(hash[:])
 }
 
+func countNodes(node *nodetree.Node) uint64 {
+	count := uint64(1)
+	for _, child := range node.Children {
+		count += countNodes(child)
+	}
+	return count
+}
+
+// collectSubtreeProfiles adds the profiles of the nodes of the subtree to
+// the maps and returns the number of nodes.
+func collectSubtreeProfiles(
+	node *nodetree.Node,
+	profileIDs map[string]struct{},
+	profiles map[utils.ExampleMetadata]struct{},
+) uint64 {
+	for id := range node.ProfileIDs {
+		profileIDs[id] = void
+	}
+	for example := range node.Profiles {
+		profiles[example] = void
+	}
+	count := uint64(1)
+	for _, child := range node.Children {
+		count += collectSubtreeProfiles(child, profileIDs, profiles)
+	}
+	return count
+}
+
 func (f *flamegraph) visitCalltree(node *nodetree.Node, currentStack *[]int) {
-	if node.SampleCount < f.minFreq {
+	if node.SampleCount < f.minFreq || node.DurationNS < f.minDurationNS {
+		f.truncation.PrunedSamples += uint64(node.SampleCount)
+		f.truncation.PrunedFrames += countNodes(node)
 		return
 	}
 
@@ -300,7 +338,7 @@ func (f *flamegraph) visitCalltree(node *nodetree.Node, currentStack *[]int) {
 		f.frames = append(f.frames, sfr)
 	}
 
-	// base case (when we reach leaf frames)
+	// base case (when we reach leaf frames or the max depth)
 	if node.Children == nil {
 		f.addSample(
 			currentStack,
@@ -309,6 +347,25 @@ func (f *flamegraph) visitCalltree(node *nodetree.Node, currentStack *[]int) {
 			node.ProfileIDs,
 			node.Profiles,
 		)
+	} else if len(*currentStack) == f.maxDepth {
+		profileIDs := make(map[string]struct{})
+		profiles := make(map[utils.ExampleMetadata]struct{})
+		for _, child := range node.Children {
+			f.truncation.TruncatedFrames += collectSubtreeProfiles(child, profileIDs, profiles)
+		}
+		for id := range node.ProfileIDs {
+			profileIDs[id] = void
+		}
+		for example := range node.Profiles {
+			profiles[example] = void
+		}
+		f.addSample(
+			currentStack,
+			uint64(node.SampleCount),
+			node.DurationNS,
+			profileIDs,
+			profiles,
+		)
 	} else {
 		totChildrenSampleCount := 0
 		var totChildrenDuration uint64
@@ -324,7 +381,7 @@ func (f *flamegraph) visitCalltree(node *nodetree.Node, currentStack *[]int) {
 		// ending at the current node.
 		diffCount := node.SampleCount - totChildrenSampleCount
 		diffDuration := node.DurationNS - totChildrenDuration
-		if diffCount >= f.minFreq {
+		if diffCount > 0 && diffCount >= f.minFreq && diffDuration >= f.minDurationNS {
 			f.addSample(
 				currentStack,
 				uint64(diffCount),
@@ -332,6 +389,8 @@ func (f *flamegraph) visitCalltree(node *nodetree.Node, currentStack *[]int) {
 				node.ProfileIDs,
 				node.Profiles,
 			)
+		} else if diffCount > 0 {
+			f.truncation.PrunedSamples += uint64(diffCount)
 		}
 	}
 	// pop last element before returning
@@ -366,6 +425,8 @@ func (f *flamegraph) getProfileIDsIndices(profileIDs map[string]struct{}) []int
 			f.profilesIDs = append(f.profilesIDs, id)
 		}
 	}
+	// Sort indices for a deterministic output since they come from a map.
+	sort.Ints(indices)
 	return indices
 }
 
@@ -380,6 +441,7 @@ func (f *flamegraph) getProfilesIndices(profiles map[utils.ExampleMetadata]struc
 			f.profiles = append(f.profiles, i)
 		}
 	}
+	sort.Ints(indices)
 	return indices
 }
 
@@ -389,15 +451,16 @@ func GetFlamegraphFromChunks(
 	projectID uint64,
 	storage *blob.Bucket,
 	chunksMetadata []ChunkMetadata,
-	jobs chan storageutil.ReadJob) (speedscope.Output, error) {
+	jobs *storageutil.Scheduler,
+	options Options) (speedscope.Output, error) {
 	hub := sentry.GetHubFromContext(ctx)
 	results := make(chan storageutil.ReadJobResult, len(chunksMetadata))
-	defer close(results)
+	batch := make([]storageutil.ReadJob, 0, cap(results))
 
 	chunkIDToMetadata := make(map[string]ChunkMetadata)
 	for _, chunkMetadata := range chunksMetadata {
 		chunkIDToMetadata[chunkMetadata.ChunkID] = chunkMetadata
-		jobs <- chunk.ReadJob{
+		batch = append(batch, chunk.ReadJob{
 			Ctx:            ctx,
 			ProfilerID:     chunkMetadata.ProfilerID,
 			ChunkID:        chunkMetadata.ChunkID,
@@ -405,7 +468,11 @@ func GetFlamegraphFromChunks(
 			ProjectID:      projectID,
 			Storage:        storage,
 			Result:         results,
-		}
+		})
+	}
+
+	if err := jobs.Submit(ctx, batch...); err != nil {
+		return speedscope.Output{}, err
 	}
 
 	var flamegraphTree []*nodetree.Node
@@ -453,13 +520,13 @@ func GetFlamegraphFromChunks(
 			)
 			for _, callTree := range callTrees {
 				slicedTree := sliceCallTree(&callTree, &intervals)
-				addCallTreeToFlamegraph(&flamegraphTree, slicedTree, annotate)
+				options.addCallTree(&flamegraphTree, slicedTree, annotate)
 			}
 		}
 		countChunksAggregated++
 	}
 
-	sp := toSpeedscope(flamegraphTree, 4, projectID)
+	sp := toSpeedscope(flamegraphTree, options, projectID)
 	if hub != nil {
 		hub.Scope().SetTag("processed_chunks", strconv.Itoa(countChunksAggregated))
 	}
@@ -472,29 +539,30 @@ func GetFlamegraphFromCandidates(
 	organizationID uint64,
 	transactionProfileCandidates []utils.TransactionProfileCandidate,
 	continuousProfileCandidates []utils.ContinuousProfileCandidate,
-	jobs chan storageutil.ReadJob,
+	jobs *storageutil.Scheduler,
 	ma *metrics.Aggregator,
+	options Options,
 ) (speedscope.Output, error) {
 	hub := sentry.GetHubFromContext(ctx)
 
 	numCandidates := len(transactionProfileCandidates) + len(continuousProfileCandidates)
 
 	results := make(chan storageutil.ReadJobResult, numCandidates)
-	defer close(results)
+	batch := make([]storageutil.ReadJob, 0, cap(results))
 
 	for _, candidate := range transactionProfileCandidates {
-		jobs <- profile.ReadJob{
+		batch = append(batch, profile.ReadJob{
 			Ctx:            ctx,
 			OrganizationID: organizationID,
 			ProjectID:      candidate.ProjectID,
 			ProfileID:      candidate.ProfileID,
 			Storage:        storage,
 			Result:         results,
-		}
+		})
 	}
 
 	for _, candidate := range continuousProfileCandidates {
-		jobs <- chunk.ReadJob{
+		batch = append(batch, chunk.ReadJob{
 			Ctx:            ctx,
 			OrganizationID: organizationID,
 			ProjectID:      candidate.ProjectID,
@@ -506,7 +574,11 @@ func GetFlamegraphFromCandidates(
 			End:            candidate.End,
 			Storage:        storage,
 			Result:         results,
-		}
+		})
+	}
+
+	if err := jobs.Submit(ctx, batch...); err != nil {
+		return speedscope.Output{}, err
 	}
 
 	var flamegraphTree []*nodetree.Node
@@ -541,7 +613,7 @@ func GetFlamegraphFromCandidates(
 			)
 
 			for _, callTree := range profileCallTrees {
-				addCallTreeToFlamegraph(&flamegraphTree, callTree, annotate)
+				options.addCallTree(&flamegraphTree, callTree, annotate)
 			}
 			// if metrics aggregator is not null, while we're at it,
 			// compute the metrics as well
@@ -579,7 +651,7 @@ func GetFlamegraphFromCandidates(
 					}
 					callTree = sliceCallTree(&callTree, &[]utils.Interval{interval})
 				}
-				addCallTreeToFlamegraph(&flamegraphTree, callTree, annotate)
+				options.addCallTree(&flamegraphTree, callTree, annotate)
 			}
 			// if metrics aggregator is not null, while we're at it,
 			// compute the metrics as well
@@ -615,7 +687,7 @@ func GetFlamegraphFromCandidates(
 		}
 	}
 
-	sp := toSpeedscope(flamegraphTree, 4, 0)
+	sp := toSpeedscope(flamegraphTree, options, 0)
 	if ma != nil {
 		fm := ma.ToMetrics()
 		sp.Metrics = &fm
@@ -159,6 +159,7 @@ func TestFlamegraphAggregation(t *testing.T) {
 					},
 					ProfileIDs: []string{"ab1", "cd2"},
 				},
+				Truncation: &speedscope.Truncation{},
 			},
 		},
 	}
@@ -199,7 +200,7 @@ func TestFlamegraphAggregation(t *testing.T) {
 				addCallTreeToFlamegraph(&ft, callTrees[0], annotateWithProfileID(p.ID()))
 			}
 
-			if diff := testutil.Diff(toSpeedscope(ft, 1, 99), test.output, options); diff != "" {
+			if diff := testutil.Diff(toSpeedscope(ft, Options{MinSampleCount: 1}, 99), test.output, options); diff != "" {
 				t.Fatalf("Result mismatch: got - want +\n%s", diff)
 			}
 		})
@@ -293,6 +294,7 @@ func TestAnnotatingWithExamples(t *testing.T) {
 						},
 					},
 				},
+				Truncation: &speedscope.Truncation{},
 			},
 		},
 	}
@@ -332,7 +334,7 @@ func TestAnnotatingWithExamples(t *testing.T) {
 			for _, example := range test.examples {
 				addCallTreeToFlamegraph(&ft, test.callTrees, annotateWithProfileExample(example))
 			}
-			if diff := testutil.Diff(toSpeedscope(ft, 1, 99), test.output, options); diff != "" {
+			if diff := testutil.Diff(toSpeedscope(ft, Options{MinSampleCount: 1}, 99), test.output, options); diff != "" {
 				t.Fatalf("Result mismatch: got - want +\n%s", diff)
 			}
 		})
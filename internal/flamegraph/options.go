@@ -0,0 +1,113 @@
+package flamegraph
+
+import (
+	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/profile"
+	"github.com/getsentry/vroom/internal/utils"
+)
+
+type Options struct {
+	// Inverted roots the trees at the frames where samples end and walks up
+	// to their callers, showing the heaviest functions first.
+	Inverted bool
+	// Transforms are applied in order to each call tree.
+	Transforms []Transform
+
+	// MinSampleCount and MinDurationNS prune frames with fewer samples or
+	// a shorter duration. Zero disables them.
+	MinSampleCount int
+	MinDurationNS  uint64
+	// MaxDepth attributes the samples of deeper frames to their ancestor at
+	// this depth. Zero disables it.
+	MaxDepth int
+}
+
+var DefaultOptions = Options{
+	MinSampleCount: 4,
+	MaxDepth:       profile.MaxStackDepth,
+}
+
+func (o Options) Validate() error {
+	for i := range o.Transforms {
+		if err := o.Transforms[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addCallTree applies the options to a call tree before adding it to the
+// flamegraph.
+func (o Options) addCallTree(
+	flamegraphTree *[]*nodetree.Node,
+	callTree []*nodetree.Node,
+	annotate func(n *nodetree.Node),
+) {
+	for _, t := range o.Transforms {
+		callTree = t.apply(callTree)
+	}
+	if o.Inverted {
+		callTree = invertCallTree(callTree)
+	}
+	addCallTreeToFlamegraph(flamegraphTree, callTree, annotate)
+}
+
+// invertCallTree returns the bottom-up version of a call tree. Each stack
+// with samples ending on its last frame is reversed and weighted by the
+// samples and duration of that frame only.
+func invertCallTree(callTree []*nodetree.Node) []*nodetree.Node {
+	var inverted []*nodetree.Node
+	stack := make([]*nodetree.Node, 0, 128)
+	var visit func(n *nodetree.Node)
+	visit = func(n *nodetree.Node) {
+		stack = append(stack, n)
+		childrenSampleCount := 0
+		var childrenDurationNS uint64
+		for _, child := range n.Children {
+			childrenSampleCount += child.SampleCount
+			childrenDurationNS += child.DurationNS
+			visit(child)
+		}
+		if n.SampleCount > childrenSampleCount {
+			var durationNS uint64
+			if n.DurationNS > childrenDurationNS {
+				durationNS = n.DurationNS - childrenDurationNS
+			}
+			addInvertedStack(&inverted, stack, n.SampleCount-childrenSampleCount, durationNS)
+		}
+		stack = stack[:len(stack)-1]
+	}
+	for _, root := range callTree {
+		visit(root)
+	}
+	return inverted
+}
+
+// addInvertedStack adds the stack from its last frame to its first one.
+func addInvertedStack(inverted *[]*nodetree.Node, stack []*nodetree.Node, sampleCount int, durationNS uint64) {
+	nodes := inverted
+	for i := len(stack) - 1; i >= 0; i-- {
+		n := getMatchingNode(nodes, stack[i])
+		if n == nil {
+			n = invertedNode(stack[i])
+			*nodes = append(*nodes, n)
+		}
+		n.SampleCount += sampleCount
+		n.DurationNS += durationNS
+		nodes = &n.Children
+	}
+}
+
+func invertedNode(n *nodetree.Node) *nodetree.Node {
+	return &nodetree.Node{
+		Fingerprint:   n.Fingerprint,
+		IsApplication: n.IsApplication,
+		Line:          n.Line,
+		Name:          n.Name,
+		Package:       n.Package,
+		Path:          n.Path,
+		Frame:         n.Frame,
+		ProfileIDs:    make(map[string]struct{}),
+		Profiles:      make(map[utils.ExampleMetadata]struct{}),
+	}
+}
@@ -0,0 +1,97 @@
+package flamegraph
+
+import (
+	"testing"
+
+	"github.com/getsentry/vroom/internal/frame"
+	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/speedscope"
+	"github.com/getsentry/vroom/internal/testutil"
+	"github.com/getsentry/vroom/internal/utils"
+)
+
+func newTestNode(name string, sampleCount int, durationNS uint64, children ...*nodetree.Node) *nodetree.Node {
+	return &nodetree.Node{
+		Children:    children,
+		DurationNS:  durationNS,
+		Frame:       frame.Frame{Function: name, Package: "pkg"},
+		Name:        name,
+		Package:     "pkg",
+		SampleCount: sampleCount,
+		ProfileIDs:  make(map[string]struct{}),
+		Profiles:    make(map[utils.ExampleMetadata]struct{}),
+	}
+}
+
+func TestInvertCallTree(t *testing.T) {
+	callTree := []*nodetree.Node{
+		newTestNode("main", 4, 40,
+			newTestNode("a", 2, 20,
+				newTestNode("leaf", 2, 20),
+			),
+			newTestNode("leaf", 1, 10),
+		),
+	}
+	want := []*nodetree.Node{
+		newTestNode("leaf", 3, 30,
+			newTestNode("a", 2, 20,
+				newTestNode("main", 2, 20),
+			),
+			newTestNode("main", 1, 10),
+		),
+		newTestNode("main", 1, 10),
+	}
+	if diff := testutil.Diff(invertCallTree(callTree), want); diff != "" {
+		t.Fatalf("Result mismatch: got - want +\n%s", diff)
+	}
+}
+
+func TestToSpeedscopeTruncation(t *testing.T) {
+	tree := []*nodetree.Node{
+		newTestNode("main", 10, 100,
+			newTestNode("a", 6, 60,
+				newTestNode("b", 6, 60,
+					newTestNode("c", 5, 50),
+				),
+			),
+			newTestNode("d", 1, 10),
+		),
+	}
+	tests := []struct {
+		name       string
+		options    Options
+		samples    [][]int
+		truncation speedscope.Truncation
+	}{
+		{
+			name:    "no limits",
+			options: Options{},
+			samples: [][]int{{0, 1, 2, 3}, {0, 1, 2}, {0, 4}, {0}},
+		},
+		{
+			name:       "min sample count and max depth",
+			options:    Options{MinSampleCount: 2, MaxDepth: 2},
+			samples:    [][]int{{0, 1}, {0}},
+			truncation: speedscope.Truncation{PrunedSamples: 1, PrunedFrames: 1, TruncatedFrames: 2},
+		},
+		{
+			name:       "min duration",
+			options:    Options{MinDurationNS: 20},
+			samples:    [][]int{{0, 1, 2, 3}, {0}},
+			truncation: speedscope.Truncation{PrunedSamples: 2, PrunedFrames: 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := toSpeedscope(tree, test.options, 1)
+			samples := output.Profiles[0].(speedscope.SampledProfile).Samples
+			if diff := testutil.Diff(samples, test.samples); diff != "" {
+				t.Fatalf("Result mismatch: got - want +\n%s", diff)
+			}
+			if diff := testutil.Diff(*output.Truncation, test.truncation); diff != "" {
+				t.Fatalf("Result mismatch: got - want +\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package flamegraph
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/utils"
+)
+
+type (
+	TransformType string
+
+	// Transform changes the shape of call trees before they're aggregated.
+	Transform struct {
+		Type TransformType `json:"type"`
+		// Function and Package select the frames to focus on. Package is
+		// optional.
+		Function string `json:"function,omitempty"`
+		// Package is the package to collapse or the one of the function to
+		// focus on.
+		Package string `json:"package,omitempty"`
+		// Pattern is a regular expression matching the packages to drop.
+		Pattern string `json:"pattern,omitempty"`
+
+		re *regexp.Regexp
+	}
+)
+
+const (
+	// TransformFocus only keeps the subtrees rooted at the function.
+	TransformFocus TransformType = "focus"
+	// TransformDropPackage removes frames of packages matching the pattern,
+	// their children are attached to their parent.
+	TransformDropPackage TransformType = "drop_package"
+	// TransformMergeRecursion merges consecutive frames of the same function.
+	TransformMergeRecursion TransformType = "merge_recursion"
+	// TransformCollapsePackage replaces consecutive frames of the package by
+	// a single frame named after it.
+	TransformCollapsePackage TransformType = "collapse_package"
+	// TransformHideSystemFrames removes system frames, their children are
+	// attached to their application parent.
+	TransformHideSystemFrames TransformType = "hide_system_frames"
+)
+
+var ErrInvalidTransform = errors.New("flamegraph: invalid transform")
+
+// Validate checks the transform has the fields its type requires and
+// compiles its pattern.
+func (t *Transform) Validate() error {
+	switch t.Type {
+	case TransformFocus:
+		if t.Function == "" {
+			return fmt.Errorf("%w: focus requires a function", ErrInvalidTransform)
+		}
+	case TransformDropPackage:
+		if t.Pattern == "" {
+			return fmt.Errorf("%w: drop_package requires a pattern", ErrInvalidTransform)
+		}
+		re, err := regexp.Compile(t.Pattern)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidTransform, err)
+		}
+		t.re = re
+	case TransformCollapsePackage:
+		if t.Package == "" {
+			return fmt.Errorf("%w: collapse_package requires a package", ErrInvalidTransform)
+		}
+	case TransformMergeRecursion, TransformHideSystemFrames:
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidTransform, t.Type)
+	}
+	return nil
+}
+
+// apply returns a transformed copy of the call tree, leaving it untouched.
+func (t Transform) apply(callTree []*nodetree.Node) []*nodetree.Node {
+	switch t.Type {
+	case TransformFocus:
+		return focusNodes(callTree, func(n *nodetree.Node) bool {
+			return n.Name == t.Function && (t.Package == "" || n.Package == t.Package)
+		})
+	case TransformDropPackage:
+		// the pattern is only compiled by Validate
+		if t.re == nil {
+			return callTree
+		}
+		return filterNodes(callTree, nil, func(n, _ *nodetree.Node) bool {
+			return t.re.MatchString(n.Package)
+		})
+	case TransformMergeRecursion:
+		return filterNodes(callTree, nil, func(n, parent *nodetree.Node) bool {
+			return parent != nil && parent.Name == n.Name && parent.Package == n.Package
+		})
+	case TransformCollapsePackage:
+		return collapsePackage(callTree, t.Package)
+	case TransformHideSystemFrames:
+		return filterNodes(callTree, nil, func(n, _ *nodetree.Node) bool {
+			return !n.IsApplication
+		})
+	}
+	return callTree
+}
+
+func copyNode(n *nodetree.Node) *nodetree.Node {
+	c := *n
+	c.Children = nil
+	c.ProfileIDs = make(map[string]struct{})
+	c.Profiles = make(map[utils.ExampleMetadata]struct{})
+	return &c
+}
+
+// mergeNode adds n to the nodes, merging it with a node of the same frame
+// if there's one.
+func mergeNode(nodes *[]*nodetree.Node, n *nodetree.Node) {
+	existing := getMatchingNode(nodes, n)
+	if existing == nil {
+		*nodes = append(*nodes, n)
+		return
+	}
+	existing.SampleCount += n.SampleCount
+	existing.DurationNS += n.DurationNS
+	for _, child := range n.Children {
+		mergeNode(&existing.Children, child)
+	}
+}
+
+// filterNodes copies the nodes without the ones to drop. The children of a
+// dropped node are attached to its closest kept ancestor so its samples are
+// credited to it.
+func filterNodes(
+	nodes []*nodetree.Node,
+	parent *nodetree.Node,
+	drop func(n, parent *nodetree.Node) bool,
+) []*nodetree.Node {
+	var filtered []*nodetree.Node
+	for _, n := range nodes {
+		if drop(n, parent) {
+			for _, child := range filterNodes(n.Children, parent, drop) {
+				mergeNode(&filtered, child)
+			}
+			continue
+		}
+		c := copyNode(n)
+		c.Children = filterNodes(n.Children, c, drop)
+		mergeNode(&filtered, c)
+	}
+	return filtered
+}
+
+func focusNodes(nodes []*nodetree.Node, match func(n *nodetree.Node) bool) []*nodetree.Node {
+	var focused []*nodetree.Node
+	for _, n := range nodes {
+		if match(n) {
+			c := copyNode(n)
+			c.Children = filterNodes(n.Children, c, func(_, _ *nodetree.Node) bool {
+				return false
+			})
+			mergeNode(&focused, c)
+			continue
+		}
+		for _, child := range focusNodes(n.Children, match) {
+			mergeNode(&focused, child)
+		}
+	}
+	return focused
+}
+
+func collapsePackage(nodes []*nodetree.Node, pkg string) []*nodetree.Node {
+	var collapsed []*nodetree.Node
+	for _, n := range nodes {
+		c := copyNode(n)
+		if n.Package != pkg {
+			c.Children = collapsePackage(n.Children, pkg)
+			mergeNode(&collapsed, c)
+			continue
+		}
+		c.Name = pkg
+		c.Frame.Function = pkg
+		c.Line = 0
+		c.Path = ""
+		for _, child := range collapsePackage(packageExits(n.Children, pkg), pkg) {
+			mergeNode(&c.Children, child)
+		}
+		mergeNode(&collapsed, c)
+	}
+	return collapsed
+}
+
+// packageExits returns the first descendants of the nodes not in the package.
+func packageExits(nodes []*nodetree.Node, pkg string) []*nodetree.Node {
+	var exits []*nodetree.Node
+	for _, n := range nodes {
+		if n.Package == pkg {
+			exits = append(exits, packageExits(n.Children, pkg)...)
+			continue
+		}
+		exits = append(exits, n)
+	}
+	return exits
+}
@@ -0,0 +1,164 @@
+package flamegraph
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/getsentry/vroom/internal/frame"
+	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/testutil"
+	"github.com/getsentry/vroom/internal/utils"
+)
+
+// newTransformNode returns a node with a duration of 10ns per sample.
+func newTransformNode(name, pkg string, inApp bool, sampleCount int, children ...*nodetree.Node) *nodetree.Node {
+	return &nodetree.Node{
+		Children:      children,
+		DurationNS:    uint64(sampleCount) * 10,
+		Frame:         frame.Frame{Function: name, Package: pkg},
+		IsApplication: inApp,
+		Name:          name,
+		Package:       pkg,
+		SampleCount:   sampleCount,
+		ProfileIDs:    make(map[string]struct{}),
+		Profiles:      make(map[utils.ExampleMetadata]struct{}),
+	}
+}
+
+func newTransformCallTree() []*nodetree.Node {
+	return []*nodetree.Node{
+		newTransformNode("main", "app", true, 10,
+			newTransformNode("read", "lib", false, 6,
+				newTransformNode("parse", "lib", false, 5,
+					newTransformNode("callback", "app", true, 4),
+				),
+			),
+			newTransformNode("a", "app", true, 4,
+				newTransformNode("a", "app", true, 3,
+					newTransformNode("callback", "app", true, 2),
+				),
+			),
+		),
+	}
+}
+
+func TestTransforms(t *testing.T) {
+	tests := []struct {
+		name      string
+		transform Transform
+		want      []*nodetree.Node
+	}{
+		{
+			name:      "focus",
+			transform: Transform{Type: TransformFocus, Function: "callback"},
+			want: []*nodetree.Node{
+				newTransformNode("callback", "app", true, 6),
+			},
+		},
+		{
+			name:      "drop package",
+			transform: Transform{Type: TransformDropPackage, Pattern: "^li"},
+			want: []*nodetree.Node{
+				newTransformNode("main", "app", true, 10,
+					newTransformNode("callback", "app", true, 4),
+					newTransformNode("a", "app", true, 4,
+						newTransformNode("a", "app", true, 3,
+							newTransformNode("callback", "app", true, 2),
+						),
+					),
+				),
+			},
+		},
+		{
+			name:      "merge recursion",
+			transform: Transform{Type: TransformMergeRecursion},
+			want: []*nodetree.Node{
+				newTransformNode("main", "app", true, 10,
+					newTransformNode("read", "lib", false, 6,
+						newTransformNode("parse", "lib", false, 5,
+							newTransformNode("callback", "app", true, 4),
+						),
+					),
+					newTransformNode("a", "app", true, 4,
+						newTransformNode("callback", "app", true, 2),
+					),
+				),
+			},
+		},
+		{
+			name:      "collapse package",
+			transform: Transform{Type: TransformCollapsePackage, Package: "lib"},
+			want: []*nodetree.Node{
+				newTransformNode("main", "app", true, 10,
+					newTransformNode("lib", "lib", false, 6,
+						newTransformNode("callback", "app", true, 4),
+					),
+					newTransformNode("a", "app", true, 4,
+						newTransformNode("a", "app", true, 3,
+							newTransformNode("callback", "app", true, 2),
+						),
+					),
+				),
+			},
+		},
+		{
+			name:      "hide system frames",
+			transform: Transform{Type: TransformHideSystemFrames},
+			want: []*nodetree.Node{
+				newTransformNode("main", "app", true, 10,
+					newTransformNode("callback", "app", true, 4),
+					newTransformNode("a", "app", true, 4,
+						newTransformNode("a", "app", true, 3,
+							newTransformNode("callback", "app", true, 2),
+						),
+					),
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.transform.Validate(); err != nil {
+				t.Fatal(err)
+			}
+			callTree := newTransformCallTree()
+			got := test.transform.apply(callTree)
+			if diff := testutil.Diff(got, test.want); diff != "" {
+				t.Fatalf("Result mismatch: got - want +\n%s", diff)
+			}
+			// The original call tree shouldn't be modified.
+			if diff := testutil.Diff(callTree, newTransformCallTree()); diff != "" {
+				t.Fatalf("Call tree modified: got - want +\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTransformApplyWithoutValidate(t *testing.T) {
+	transform := Transform{Type: TransformDropPackage, Pattern: "("}
+	got := transform.apply(newTransformCallTree())
+	if diff := testutil.Diff(got, newTransformCallTree()); diff != "" {
+		t.Fatalf("Result mismatch: got - want +\n%s", diff)
+	}
+}
+
+func TestTransformValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		transform Transform
+	}{
+		{name: "unknown type", transform: Transform{Type: "reverse"}},
+		{name: "focus without function", transform: Transform{Type: TransformFocus}},
+		{name: "invalid pattern", transform: Transform{Type: TransformDropPackage, Pattern: "("}},
+		{name: "collapse without package", transform: Transform{Type: TransformCollapsePackage}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.transform.Validate(); !errors.Is(err, ErrInvalidTransform) {
+				t.Fatalf("expected ErrInvalidTransform, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+// Package grpcutil holds the gRPC counterparts of the HTTP middlewares of
+// httputil: tracing, authentication, rate limiting and read options.
+package grpcutil
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	grpcproto "google.golang.org/grpc/encoding/proto"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/getsentry/vroom/internal/httputil"
+	"github.com/getsentry/vroom/internal/ratelimit"
+	"github.com/getsentry/vroom/internal/storageutil"
+	"github.com/getsentry/vroom/internal/telemetry"
+)
+
+// RetryAfterKey is the metadata telling a rate limited client how many
+// seconds to wait before retrying.
+const RetryAfterKey = "retry-after"
+
+// Metadata keys carrying the signature of a request, the same values as the
+// HTTP headers of httputil.
+var (
+	KeyIDKey     = strings.ToLower(httputil.KeyIDHeader)
+	TimestampKey = strings.ToLower(httputil.TimestampHeader)
+	SignatureKey = strings.ToLower(httputil.SignatureHeader)
+)
+
+type (
+	// Interceptor inspects a request before it's handled. It returns the
+	// context to handle the request with or an error rejecting it. The same
+	// interceptors serve unary and server-streaming methods.
+	Interceptor func(ctx context.Context, method string, req interface{}) (context.Context, error)
+
+	// organizationRequest is implemented by the requests about an
+	// organization.
+	organizationRequest interface {
+		GetOrganizationId() uint64
+	}
+
+	// serverStream runs the interceptors on the request of a
+	// server-streaming method, received by the handler.
+	serverStream struct {
+		grpc.ServerStream
+		ctx          context.Context
+		method       string
+		interceptors []Interceptor
+		received     bool
+	}
+)
+
+// UnaryServerInterceptor runs the interceptors in order on the requests of
+// unary methods.
+func UnaryServerInterceptor(interceptors ...Interceptor) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		for _, intercept := range interceptors {
+			var err error
+			ctx, err = intercept(ctx, info.FullMethod, req)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor runs the interceptors in order on the request of
+// server-streaming methods.
+func StreamServerInterceptor(interceptors ...Interceptor) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &serverStream{
+			ServerStream: ss,
+			ctx:          ss.Context(),
+			method:       info.FullMethod,
+			interceptors: interceptors,
+		})
+	}
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil || s.received {
+		return err
+	}
+	s.received = true
+	for _, intercept := range s.interceptors {
+		s.ctx, err = intercept(s.ctx, s.method, m)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Trace handles each request in its own Sentry transaction and records its
+// status and latency.
+func Trace() (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	start := func(ctx context.Context, method string) (context.Context, func(error)) {
+		begin := time.Now()
+		hub := sentry.GetHubFromContext(ctx)
+		if hub == nil {
+			hub = sentry.CurrentHub().Clone()
+			ctx = sentry.SetHubOnContext(ctx, hub)
+		}
+		transaction := sentry.StartTransaction(ctx, method, sentry.WithOpName("grpc.server"))
+		return transaction.Context(), func(err error) {
+			code := status.Code(err)
+			transaction.SetTag("grpc.status_code", code.String())
+			if code == codes.OK {
+				transaction.Status = sentry.SpanStatusOK
+			} else {
+				transaction.Status = sentry.SpanStatusInternalError
+			}
+			transaction.Finish()
+			telemetry.GRPCRequests.WithLabelValues(method, code.String()).Inc()
+			telemetry.GRPCRequestDuration.WithLabelValues(method).Observe(time.Since(begin).Seconds())
+		}
+	}
+	unary := func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx, finish := start(ctx, info.FullMethod)
+		resp, err := handler(ctx, req)
+		finish(err)
+		return resp, err
+	}
+	stream := func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, finish := start(ss.Context(), info.FullMethod)
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx, method: info.FullMethod})
+		finish(err)
+		return err
+	}
+	return unary, stream
+}
+
+// WithReadOptions schedules the storage reads of each method with its options
+// or the fallback ones.
+func WithReadOptions(
+	options map[string]storageutil.ReadOptions,
+	fallback storageutil.ReadOptions,
+) Interceptor {
+	return func(ctx context.Context, method string, _ interface{}) (context.Context, error) {
+		o, ok := options[method]
+		if !ok {
+			o = fallback
+		}
+		return storageutil.WithReadOptions(ctx, o), nil
+	}
+}
+
+// RateLimit rejects the requests to the methods of organizations over their
+// limit with a ResourceExhausted error.
+func RateLimit(limiter *ratelimit.Limiter, methods map[string]struct{}) Interceptor {
+	return func(ctx context.Context, method string, req interface{}) (context.Context, error) {
+		if _, limited := methods[method]; !limited {
+			return ctx, nil
+		}
+		r, ok := req.(organizationRequest)
+		if !ok {
+			return ctx, nil
+		}
+		if ok, retryAfter := limiter.Allow(r.GetOrganizationId(), 0); !ok {
+			telemetry.RateLimitedRequests.WithLabelValues(telemetry.RateLimitRead).Inc()
+			seconds := int(math.Max(1, math.Ceil(retryAfter.Seconds())))
+			_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterKey, strconv.Itoa(seconds)))
+			return ctx, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+		return ctx, nil
+	}
+}
+
+// Authenticate rejects requests without a valid signature with an
+// Unauthenticated error and the ones the key isn't allowed to make with a
+// PermissionDenied error. Requests are signed like HTTP ones with the POST
+// method, the full method name as path and the serialized request message,
+// as sent, as body. Key routes restrict the full method names.
+//
+// The server option installs the codec recording the hash of the requests
+// as received, the interceptor has to run first on each request it decodes.
+func Authenticate(a *httputil.Authenticator) (grpc.ServerOption, Interceptor) {
+	codec := &hashingCodec{Codec: encoding.GetCodec(grpcproto.Name)}
+	return grpc.ForceServerCodec(codec), func(ctx context.Context, method string, req interface{}) (context.Context, error) {
+		bodyHash, ok := codec.hashes.LoadAndDelete(req)
+		if !ok {
+			return ctx, status.Error(codes.Internal, "request wasn't decoded by the authenticating codec")
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		first := func(key string) string {
+			if values := md.Get(key); len(values) > 0 {
+				return values[0]
+			}
+			return ""
+		}
+		r := httputil.SignedRequest{
+			KeyID:     first(KeyIDKey),
+			Timestamp: first(TimestampKey),
+			Signature: first(SignatureKey),
+			Method:    "POST",
+			Path:      method,
+			BodyHash:  bodyHash.(string),
+		}
+		if o, ok := req.(organizationRequest); ok {
+			r.OrganizationID = o.GetOrganizationId()
+		}
+		ctx, err := a.Verify(ctx, method, r)
+		switch {
+		case err == nil:
+			return ctx, nil
+		case errors.Is(err, httputil.ErrForbidden):
+			return ctx, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return ctx, status.Error(codes.Unauthenticated, err.Error())
+		}
+	}
+}
+
+// hashingCodec decodes messages with the wrapped codec and records the hash
+// of their serialization, as it can differ between implementations.
+type hashingCodec struct {
+	encoding.Codec
+
+	// hashes maps the decoded messages to the hash of their serialization.
+	hashes sync.Map
+}
+
+func (c *hashingCodec) Unmarshal(data []byte, v interface{}) error {
+	if err := c.Codec.Unmarshal(data, v); err != nil {
+		return err
+	}
+	c.hashes.Store(v, httputil.BodyHash(data))
+	return nil
+}
@@ -0,0 +1,233 @@
+package grpcutil
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding"
+	grpcproto "google.golang.org/grpc/encoding/proto"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/getsentry/vroom/internal/httputil"
+	"github.com/getsentry/vroom/internal/ratelimit"
+	"github.com/getsentry/vroom/internal/storageutil"
+	"github.com/getsentry/vroom/pkg/vroompb"
+)
+
+type testServer struct {
+	vroompb.UnimplementedVroomServer
+
+	priorities chan storageutil.Priority
+}
+
+func (s *testServer) GetMetrics(
+	ctx context.Context,
+	_ *vroompb.GetMetricsRequest,
+) (*vroompb.GetMetricsResponse, error) {
+	s.priorities <- storageutil.ReadOptionsFromContext(ctx).Priority
+	return &vroompb.GetMetricsResponse{}, nil
+}
+
+func (s *testServer) GetFlamegraph(
+	_ *vroompb.GetFlamegraphRequest,
+	stream vroompb.Vroom_GetFlamegraphServer,
+) error {
+	s.priorities <- storageutil.ReadOptionsFromContext(stream.Context()).Priority
+	return stream.Send(&vroompb.FlamegraphPart{})
+}
+
+// rawCodec sends byte slices as they are.
+type rawCodec struct {
+	encoding.Codec
+}
+
+func (c rawCodec) Marshal(v interface{}) ([]byte, error) {
+	if b, ok := v.([]byte); ok {
+		return b, nil
+	}
+	return c.Codec.Marshal(v)
+}
+
+func TestInterceptors(t *testing.T) {
+	secret := []byte("secret")
+	a := httputil.NewAuthenticator([]httputil.AuthKey{
+		{ID: "all", Secrets: []string{string(secret)}},
+		{ID: "flamegraph", Secrets: []string{string(secret)}, Routes: []string{vroompb.Vroom_GetFlamegraph_FullMethodName}},
+	}, 5*time.Minute)
+	limiter := ratelimit.NewLimiter(ratelimit.Limit{}, map[uint64]ratelimit.Limit{2: {Rate: 1, Burst: 1}})
+
+	codec, authenticate := Authenticate(a)
+	interceptors := []Interceptor{
+		authenticate,
+		RateLimit(limiter, map[string]struct{}{vroompb.Vroom_GetMetrics_FullMethodName: {}}),
+		WithReadOptions(
+			map[string]storageutil.ReadOptions{
+				vroompb.Vroom_GetMetrics_FullMethodName: {Priority: storageutil.PriorityLow},
+			},
+			storageutil.ReadOptions{Priority: storageutil.PriorityNormal},
+		),
+	}
+	ts := &testServer{priorities: make(chan storageutil.Priority, 1)}
+	server := grpc.NewServer(
+		codec,
+		grpc.UnaryInterceptor(UnaryServerInterceptor(interceptors...)),
+		grpc.StreamInterceptor(StreamServerInterceptor(interceptors...)),
+	)
+	vroompb.RegisterVroomServer(server, ts)
+	listener := bufconn.Listen(1 << 20)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("can't dial: %v", err)
+	}
+	defer conn.Close()
+	client := vroompb.NewVroomClient(conn)
+
+	signBody := func(keyID, method string, body []byte) context.Context {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		return metadata.AppendToOutgoingContext(
+			context.Background(),
+			KeyIDKey, keyID,
+			TimestampKey, timestamp,
+			SignatureKey, httputil.Sign(secret, "POST", method, timestamp, body),
+		)
+	}
+	sign := func(keyID, method string, req proto.Message) context.Context {
+		body, err := proto.Marshal(req)
+		if err != nil {
+			t.Fatalf("can't marshal request: %v", err)
+		}
+		return signBody(keyID, method, body)
+	}
+
+	tests := []struct {
+		name         string
+		call         func() error
+		wantCode     codes.Code
+		wantPriority storageutil.Priority
+	}{
+		{
+			name: "unary",
+			call: func() error {
+				req := &vroompb.GetMetricsRequest{OrganizationId: 1}
+				_, err := client.GetMetrics(sign("all", vroompb.Vroom_GetMetrics_FullMethodName, req), req)
+				return err
+			},
+			wantCode:     codes.OK,
+			wantPriority: storageutil.PriorityLow,
+		},
+		{
+			name: "stream",
+			call: func() error {
+				req := &vroompb.GetFlamegraphRequest{OrganizationId: 1}
+				stream, err := client.GetFlamegraph(sign("all", vroompb.Vroom_GetFlamegraph_FullMethodName, req), req)
+				if err != nil {
+					return err
+				}
+				_, err = stream.Recv()
+				return err
+			},
+			wantCode:     codes.OK,
+			wantPriority: storageutil.PriorityNormal,
+		},
+		{
+			// Fields out of order, the serialization of another
+			// implementation, are signed as sent.
+			name: "serialized by another implementation",
+			call: func() error {
+				var body []byte
+				body = protowire.AppendTag(body, 8, protowire.VarintType)
+				body = protowire.AppendVarint(body, 60)
+				body = protowire.AppendTag(body, 1, protowire.VarintType)
+				body = protowire.AppendVarint(body, 1)
+				return conn.Invoke(
+					signBody("all", vroompb.Vroom_GetMetrics_FullMethodName, body),
+					vroompb.Vroom_GetMetrics_FullMethodName,
+					body,
+					&vroompb.GetMetricsResponse{},
+					grpc.ForceCodec(rawCodec{Codec: encoding.GetCodec(grpcproto.Name)}),
+				)
+			},
+			wantCode:     codes.OK,
+			wantPriority: storageutil.PriorityLow,
+		},
+		{
+			name: "missing signature",
+			call: func() error {
+				_, err := client.GetMetrics(context.Background(), &vroompb.GetMetricsRequest{OrganizationId: 1})
+				return err
+			},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "tampered request",
+			call: func() error {
+				ctx := sign("all", vroompb.Vroom_GetMetrics_FullMethodName, &vroompb.GetMetricsRequest{OrganizationId: 1})
+				_, err := client.GetMetrics(ctx, &vroompb.GetMetricsRequest{OrganizationId: 3})
+				return err
+			},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "method not allowed",
+			call: func() error {
+				req := &vroompb.GetMetricsRequest{OrganizationId: 1}
+				_, err := client.GetMetrics(sign("flamegraph", vroompb.Vroom_GetMetrics_FullMethodName, req), req)
+				return err
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "within the rate limit",
+			call: func() error {
+				req := &vroompb.GetMetricsRequest{OrganizationId: 2}
+				_, err := client.GetMetrics(sign("all", vroompb.Vroom_GetMetrics_FullMethodName, req), req)
+				return err
+			},
+			wantCode:     codes.OK,
+			wantPriority: storageutil.PriorityLow,
+		},
+		{
+			name: "over the rate limit",
+			call: func() error {
+				req := &vroompb.GetMetricsRequest{OrganizationId: 2}
+				_, err := client.GetMetrics(sign("all", vroompb.Vroom_GetMetrics_FullMethodName, req), req)
+				return err
+			},
+			wantCode: codes.ResourceExhausted,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.call()
+			if code := status.Code(err); code != test.wantCode {
+				t.Fatalf("expected %s, got %s (%v)", test.wantCode, code, err)
+			}
+			if test.wantCode != codes.OK {
+				return
+			}
+			if priority := <-ts.priorities; priority != test.wantPriority {
+				t.Fatalf("expected priority %d, got %d", test.wantPriority, priority)
+			}
+		})
+	}
+}
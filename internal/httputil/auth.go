@@ -0,0 +1,278 @@
+package httputil
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	KeyIDHeader     = "X-Vroom-Key-Id"
+	TimestampHeader = "X-Vroom-Timestamp"
+	SignatureHeader = "X-Vroom-Signature"
+)
+
+var (
+	// ErrUnauthenticated and ErrForbidden wrap the errors returned by
+	// Authenticator.Verify.
+	ErrUnauthenticated = errors.New("unauthenticated")
+	ErrForbidden       = errors.New("forbidden")
+
+	errMissingSignature = errors.New("missing signature headers")
+	errUnknownKey       = errors.New("unknown key")
+	errExpiredTimestamp = errors.New("timestamp outside of the allowed clock skew")
+	errInvalidSignature = errors.New("invalid signature")
+	errRouteNotAllowed  = errors.New("route not allowed for key")
+	errOrgNotAllowed    = errors.New("organization not allowed for key")
+)
+
+type (
+	// AuthKey is a shared secret clients sign their requests with.
+	AuthKey struct {
+		ID string `json:"id"`
+		// Secrets are all accepted so a secret can be rotated by adding the
+		// new one before clients use it and removing the old one after.
+		Secrets []string `json:"secrets"`
+		// Routes and Organizations restrict what the key can access. Empty
+		// means no restriction. Organizations apply to the organization_id
+		// parameter of routes and, on routes taking organizations from the
+		// payload, to the ones handlers check with OrganizationAllowed.
+		Routes        []string `json:"routes,omitempty"`
+		Organizations []uint64 `json:"organizations,omitempty"`
+	}
+
+	// Authenticator checks requests are signed with a known key. The
+	// signature is the hex encoded HMAC-SHA256 of the method, path with its
+	// query string, timestamp and hex encoded SHA-256 of the body, separated
+	// by new lines.
+	Authenticator struct {
+		keys    map[string]authKey
+		maxSkew time.Duration
+		now     func() time.Time
+	}
+
+	// SignedRequest is a request made outside of HTTP, such as a gRPC call
+	// where the path is the full method name and the body the serialized
+	// request message. BodyHash is the BodyHash of the body as sent.
+	SignedRequest struct {
+		KeyID          string
+		Timestamp      string
+		Signature      string
+		Method         string
+		Path           string
+		BodyHash       string
+		OrganizationID uint64
+	}
+
+	authKey struct {
+		secrets       [][]byte
+		routes        map[string]struct{}
+		organizations map[uint64]struct{}
+	}
+
+	organizationsKey struct{}
+)
+
+// ParseAuthKeys reads keys from their JSON representation.
+func ParseAuthKeys(s string) ([]AuthKey, error) {
+	var keys []AuthKey
+	err := json.Unmarshal([]byte(s), &keys)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range keys {
+		if k.ID == "" || len(k.Secrets) == 0 {
+			return nil, fmt.Errorf("auth key %q needs an ID and a secret", k.ID)
+		}
+	}
+	return keys, nil
+}
+
+func NewAuthenticator(keys []AuthKey, maxSkew time.Duration) *Authenticator {
+	a := &Authenticator{
+		keys:    make(map[string]authKey, len(keys)),
+		maxSkew: maxSkew,
+		now:     time.Now,
+	}
+	for _, k := range keys {
+		key := authKey{}
+		for _, s := range k.Secrets {
+			key.secrets = append(key.secrets, []byte(s))
+		}
+		if len(k.Routes) > 0 {
+			key.routes = make(map[string]struct{}, len(k.Routes))
+			for _, r := range k.Routes {
+				key.routes[r] = struct{}{}
+			}
+		}
+		if len(k.Organizations) > 0 {
+			key.organizations = make(map[uint64]struct{}, len(k.Organizations))
+			for _, o := range k.Organizations {
+				key.organizations[o] = struct{}{}
+			}
+		}
+		a.keys[k.ID] = key
+	}
+	return a
+}
+
+// Sign returns the signature of a request.
+func Sign(secret []byte, method, path, timestamp string, body []byte) string {
+	return signBodyHash(secret, method, path, timestamp, BodyHash(body))
+}
+
+// BodyHash returns the hex encoded SHA-256 of a body, as signed.
+func BodyHash(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+func signBodyHash(secret []byte, method, path, timestamp, bodyHash string) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = io.WriteString(mac, method+"\n"+path+"\n"+timestamp+"\n"+bodyHash)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Authenticate rejects requests to the route without a valid signature with
+// a 401 and the ones the key isn't allowed to make with a 403. It reads the
+// body as sent, before it's decompressed.
+func (a *Authenticator) Authenticate(route string, handler http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		keyID := r.Header.Get(KeyIDHeader)
+		timestamp := r.Header.Get(TimestampHeader)
+		signature := r.Header.Get(SignatureHeader)
+		if keyID == "" || timestamp == "" || signature == "" {
+			http.Error(w, errMissingSignature.Error(), http.StatusUnauthorized)
+			return
+		}
+		key, err := a.key(keyID, timestamp)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			if IsBodyTooLarge(err) {
+				w.WriteHeader(http.StatusRequestEntityTooLarge)
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		if !key.verify(r.Method, r.URL.RequestURI(), timestamp, BodyHash(body), signature) {
+			http.Error(w, errInvalidSignature.Error(), http.StatusUnauthorized)
+			return
+		}
+		ps := httprouter.ParamsFromContext(r.Context())
+		if err := key.allow(route, ps.ByName("organization_id")); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		handler.ServeHTTP(w, r.WithContext(key.withOrganizations(r.Context())))
+	}
+}
+
+// Verify checks a request made outside of HTTP is signed with a known key
+// allowed on the route and for the organization, 0 meaning the request isn't
+// about an organization. It returns the context to handle the request with,
+// the errors wrap ErrUnauthenticated or ErrForbidden.
+func (a *Authenticator) Verify(ctx context.Context, route string, r SignedRequest) (context.Context, error) {
+	if r.KeyID == "" || r.Timestamp == "" || r.Signature == "" {
+		return ctx, fmt.Errorf("%w: %w", ErrUnauthenticated, errMissingSignature)
+	}
+	key, err := a.key(r.KeyID, r.Timestamp)
+	if err != nil {
+		return ctx, fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+	}
+	if !key.verify(r.Method, r.Path, r.Timestamp, r.BodyHash, r.Signature) {
+		return ctx, fmt.Errorf("%w: %w", ErrUnauthenticated, errInvalidSignature)
+	}
+	var rawOrganizationID string
+	if r.OrganizationID != 0 {
+		rawOrganizationID = strconv.FormatUint(r.OrganizationID, 10)
+	}
+	if err := key.allow(route, rawOrganizationID); err != nil {
+		return ctx, fmt.Errorf("%w: %w", ErrForbidden, err)
+	}
+	return key.withOrganizations(ctx), nil
+}
+
+// OrganizationAllowed returns whether the key a request was authenticated
+// with is allowed for the organization. Handlers taking organizations from
+// the payload check them with it.
+func OrganizationAllowed(ctx context.Context, organizationID uint64) bool {
+	organizations, ok := ctx.Value(organizationsKey{}).(map[uint64]struct{})
+	if !ok {
+		return true
+	}
+	_, ok = organizations[organizationID]
+	return ok
+}
+
+// key returns the key with the ID if the timestamp is within the allowed
+// clock skew.
+func (a *Authenticator) key(keyID, timestamp string) (authKey, error) {
+	key, exists := a.keys[keyID]
+	if !exists {
+		return authKey{}, errUnknownKey
+	}
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return authKey{}, errExpiredTimestamp
+	}
+	skew := a.now().Sub(time.Unix(seconds, 0))
+	if skew > a.maxSkew || skew < -a.maxSkew {
+		return authKey{}, errExpiredTimestamp
+	}
+	return key, nil
+}
+
+func (k authKey) verify(method, path, timestamp, bodyHash, signature string) bool {
+	for _, secret := range k.secrets {
+		expected := signBodyHash(secret, method, path, timestamp, bodyHash)
+		if hmac.Equal([]byte(expected), []byte(signature)) {
+			return true
+		}
+	}
+	return false
+}
+
+// withOrganizations returns a context restricted to the organizations of the
+// key, if it's restricted.
+func (k authKey) withOrganizations(ctx context.Context) context.Context {
+	if k.organizations == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, organizationsKey{}, k.organizations)
+}
+
+func (k authKey) allow(route, rawOrganizationID string) error {
+	if k.routes != nil {
+		if _, ok := k.routes[route]; !ok {
+			return errRouteNotAllowed
+		}
+	}
+	if k.organizations == nil || rawOrganizationID == "" {
+		return nil
+	}
+	organizationID, err := strconv.ParseUint(rawOrganizationID, 10, 64)
+	if err != nil {
+		return errOrgNotAllowed
+	}
+	if _, ok := k.organizations[organizationID]; !ok {
+		return errOrgNotAllowed
+	}
+	return nil
+}
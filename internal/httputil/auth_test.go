@@ -0,0 +1,245 @@
+package httputil
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestAuthenticate(t *testing.T) {
+	const route = "/organizations/:organization_id/flamegraph"
+	now := time.Unix(1700000000, 0)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	body := []byte(`{"transaction":[]}`)
+
+	a := NewAuthenticator([]AuthKey{
+		{ID: "all", Secrets: []string{"new", "old"}},
+		{ID: "restricted", Secrets: []string{"secret"}, Routes: []string{route}, Organizations: []uint64{1}},
+		{ID: "other-route", Secrets: []string{"secret"}, Routes: []string{"/profile"}},
+	}, 5*time.Minute)
+	a.now = func() time.Time { return now }
+
+	var received []byte
+	router := httprouter.New()
+	router.Handler(http.MethodPost, route, a.Authenticate(route, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	tests := []struct {
+		name      string
+		path      string
+		target    string
+		keyID     string
+		secret    string
+		timestamp string
+		signed    []byte
+		want      int
+	}{
+		{
+			name:      "valid signature",
+			path:      "/organizations/1/flamegraph",
+			keyID:     "all",
+			secret:    "new",
+			timestamp: timestamp,
+			signed:    body,
+			want:      http.StatusOK,
+		},
+		{
+			name:      "previous secret during rotation",
+			path:      "/organizations/1/flamegraph",
+			keyID:     "all",
+			secret:    "old",
+			timestamp: timestamp,
+			signed:    body,
+			want:      http.StatusOK,
+		},
+		{
+			name:      "missing signature",
+			path:      "/organizations/1/flamegraph",
+			timestamp: timestamp,
+			want:      http.StatusUnauthorized,
+		},
+		{
+			name:      "unknown key",
+			path:      "/organizations/1/flamegraph",
+			keyID:     "unknown",
+			secret:    "new",
+			timestamp: timestamp,
+			signed:    body,
+			want:      http.StatusUnauthorized,
+		},
+		{
+			name:      "wrong secret",
+			path:      "/organizations/1/flamegraph",
+			keyID:     "all",
+			secret:    "wrong",
+			timestamp: timestamp,
+			signed:    body,
+			want:      http.StatusUnauthorized,
+		},
+		{
+			name:      "tampered body",
+			path:      "/organizations/1/flamegraph",
+			keyID:     "all",
+			secret:    "new",
+			timestamp: timestamp,
+			signed:    []byte(`{"transaction":null}`),
+			want:      http.StatusUnauthorized,
+		},
+		{
+			name:      "signed query",
+			path:      "/organizations/1/flamegraph?generate_metrics=true",
+			keyID:     "all",
+			secret:    "new",
+			timestamp: timestamp,
+			signed:    body,
+			want:      http.StatusOK,
+		},
+		{
+			name:      "tampered query",
+			path:      "/organizations/1/flamegraph?generate_metrics=true",
+			target:    "/organizations/1/flamegraph?generate_metrics=false",
+			keyID:     "all",
+			secret:    "new",
+			timestamp: timestamp,
+			signed:    body,
+			want:      http.StatusUnauthorized,
+		},
+		{
+			name:      "expired timestamp",
+			path:      "/organizations/1/flamegraph",
+			keyID:     "all",
+			secret:    "new",
+			timestamp: strconv.FormatInt(now.Add(-10*time.Minute).Unix(), 10),
+			signed:    body,
+			want:      http.StatusUnauthorized,
+		},
+		{
+			name:      "allowed organization",
+			path:      "/organizations/1/flamegraph",
+			keyID:     "restricted",
+			secret:    "secret",
+			timestamp: timestamp,
+			signed:    body,
+			want:      http.StatusOK,
+		},
+		{
+			name:      "organization not allowed",
+			path:      "/organizations/2/flamegraph",
+			keyID:     "restricted",
+			secret:    "secret",
+			timestamp: timestamp,
+			signed:    body,
+			want:      http.StatusForbidden,
+		},
+		{
+			name:      "route not allowed",
+			path:      "/organizations/1/flamegraph",
+			keyID:     "other-route",
+			secret:    "secret",
+			timestamp: timestamp,
+			signed:    body,
+			want:      http.StatusForbidden,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			received = nil
+			target := test.target
+			if target == "" {
+				target = test.path
+			}
+			req := httptest.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+			if test.keyID != "" {
+				req.Header.Set(KeyIDHeader, test.keyID)
+				req.Header.Set(TimestampHeader, test.timestamp)
+				req.Header.Set(SignatureHeader, Sign([]byte(test.secret), http.MethodPost, test.path, test.timestamp, test.signed))
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			if w.Code != test.want {
+				t.Fatalf("expected status %d, got %d", test.want, w.Code)
+			}
+			if test.want == http.StatusOK && !bytes.Equal(received, body) {
+				t.Fatalf("expected the handler to read the body, got %q", received)
+			}
+		})
+	}
+}
+
+func TestAuthenticateOrganizationFromPayload(t *testing.T) {
+	const route = "/profile"
+	now := time.Unix(1700000000, 0)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	a := NewAuthenticator([]AuthKey{
+		{ID: "all", Secrets: []string{"secret"}},
+		{ID: "restricted", Secrets: []string{"secret"}, Organizations: []uint64{1}},
+	}, 5*time.Minute)
+	a.now = func() time.Time { return now }
+
+	router := httprouter.New()
+	router.Handler(http.MethodPost, route, a.Authenticate(route, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			OrganizationID uint64 `json:"organization_id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if !OrganizationAllowed(r.Context(), payload.OrganizationID) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	tests := []struct {
+		name  string
+		keyID string
+		body  []byte
+		want  int
+	}{
+		{
+			name:  "unrestricted key",
+			keyID: "all",
+			body:  []byte(`{"organization_id":2}`),
+			want:  http.StatusOK,
+		},
+		{
+			name:  "allowed organization",
+			keyID: "restricted",
+			body:  []byte(`{"organization_id":1}`),
+			want:  http.StatusOK,
+		},
+		{
+			name:  "organization not allowed",
+			keyID: "restricted",
+			body:  []byte(`{"organization_id":2}`),
+			want:  http.StatusForbidden,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, route, bytes.NewReader(test.body))
+			req.Header.Set(KeyIDHeader, test.keyID)
+			req.Header.Set(TimestampHeader, timestamp)
+			req.Header.Set(SignatureHeader, Sign([]byte("secret"), http.MethodPost, route, timestamp, test.body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			if w.Code != test.want {
+				t.Fatalf("expected status %d, got %d", test.want, w.Code)
+			}
+		})
+	}
+}
@@ -1,21 +1,145 @@
 package httputil
 
 import (
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
 )
 
-// DecompressPayload adds a reader of the right type in case you need to decompress the body.
-func DecompressPayload(next http.Handler) http.HandlerFunc {
+// DecompressPayload decodes the body according to its Content-Encoding.
+// Encodings are listed in the order they were applied and decoded in reverse,
+// requests with an unknown one or more than maxContentEncodings are rejected
+// with a 415. maxSize bounds the
+// memory decoders allocate to what a body they can decompress to needs, zero
+// disables the bound.
+func DecompressPayload(maxSize int64, next http.Handler) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		defer r.Body.Close()
 
-		if r.Header.Get("Content-Encoding") == "br" {
-			r.Body = io.NopCloser(brotli.NewReader(r.Body))
+		encodings := contentEncodings(r.Header)
+		if len(encodings) > maxContentEncodings {
+			http.Error(w, errTooManyEncodings.Error(), http.StatusUnsupportedMediaType)
+			return
+		}
+		var closers []io.Closer
+		defer func() {
+			for _, c := range closers {
+				c.Close()
+			}
+		}()
+		for i := len(encodings) - 1; i >= 0; i-- {
+			body, err := decodeBody(encodings[i], r.Body, maxSize)
+			if err != nil {
+				if errors.Is(err, errUnsupportedEncoding) {
+					http.Error(w, err.Error(), http.StatusUnsupportedMediaType)
+					return
+				}
+				// Decoders read a header, the body can already be too large.
+				if IsBodyTooLarge(err) {
+					http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+					return
+				}
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			closers = append(closers, body)
+			r.Body = body
+		}
+		if len(encodings) > 0 {
+			// The body isn't encoded anymore.
+			r.Header.Del("Content-Encoding")
+			r.ContentLength = -1
 		}
 
 		next.ServeHTTP(w, r)
 	})
 }
+
+// maxContentEncodings is the number of stacked encodings accepted, each one
+// multiplies what a small body can decompress to.
+const maxContentEncodings = 2
+
+var (
+	errUnsupportedEncoding = errors.New("unsupported content encoding")
+	errTooManyEncodings    = errors.New("too many content encodings")
+)
+
+func contentEncodings(h http.Header) []string {
+	var encodings []string
+	for _, value := range h.Values("Content-Encoding") {
+		for _, encoding := range strings.Split(value, ",") {
+			encoding = strings.ToLower(strings.TrimSpace(encoding))
+			if encoding == "" || encoding == "identity" {
+				continue
+			}
+			encodings = append(encodings, encoding)
+		}
+	}
+	return encodings
+}
+
+func decodeBody(encoding string, body io.Reader, maxSize int64) (io.ReadCloser, error) {
+	switch encoding {
+	case "br":
+		return io.NopCloser(brotli.NewReader(body)), nil
+	case "gzip", "x-gzip":
+		return gzip.NewReader(body)
+	case "deflate":
+		return zlib.NewReader(body)
+	case "zstd":
+		d, err := zstd.NewReader(body, zstdOptions(maxSize)...)
+		if err != nil {
+			return nil, err
+		}
+		return d.IOReadCloser(), nil
+	case "lz4":
+		return io.NopCloser(lz4.NewReader(body)), nil
+	}
+	return nil, fmt.Errorf("%w: %s", errUnsupportedEncoding, encoding)
+}
+
+// zstdOptions decode on the request goroutine and, with a maximum size,
+// reject frames whose window is larger than the body could be.
+func zstdOptions(maxSize int64) []zstd.DOption {
+	options := []zstd.DOption{zstd.WithDecoderConcurrency(1)}
+	if maxSize <= 0 {
+		return options
+	}
+	window := uint64(maxSize)
+	if window < zstd.MinWindowSize {
+		window = zstd.MinWindowSize
+	} else if window > zstd.MaxWindowSize {
+		window = zstd.MaxWindowSize
+	}
+	return append(
+		options,
+		zstd.WithDecoderMaxWindow(window),
+		zstd.WithDecoderMaxMemory(uint64(maxSize)),
+	)
+}
+
+// LimitBody fails reads of the body past maxSize with an
+// *http.MaxBytesError. It's used before decompression to limit what's sent
+// and after to limit what it decompresses to. Zero disables the limit.
+func LimitBody(maxSize int64, next http.Handler) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if maxSize > 0 {
+			r.Body = http.MaxBytesReader(w, r.Body, maxSize)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// IsBodyTooLarge returns whether reading the body failed because of its size.
+func IsBodyTooLarge(err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	return errors.As(err, &maxBytesErr)
+}
@@ -0,0 +1,187 @@
+package httputil
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+func encode(t *testing.T, encoding string, data []byte) []byte {
+	t.Helper()
+	var b bytes.Buffer
+	var w io.WriteCloser
+	switch encoding {
+	case "br":
+		w = brotli.NewWriter(&b)
+	case "gzip":
+		w = gzip.NewWriter(&b)
+	case "deflate":
+		w = zlib.NewWriter(&b)
+	case "zstd":
+		zw, err := zstd.NewWriter(&b)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		w = zw
+	case "lz4":
+		w = lz4.NewWriter(&b)
+	default:
+		t.Fatalf("unknown encoding %s", encoding)
+	}
+	if _, err := w.Write(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return b.Bytes()
+}
+
+// zstdFrame returns a zstd frame holding data in a raw block and announcing a
+// window of 1 << windowLog bytes.
+func zstdFrame(windowLog uint, data []byte) []byte {
+	frame := []byte{0x28, 0xb5, 0x2f, 0xfd, 0x00, byte(windowLog-10) << 3}
+	header := 1 | uint32(len(data))<<3
+	frame = append(frame, byte(header), byte(header>>8), byte(header>>16))
+	return append(frame, data...)
+}
+
+func TestDecompressPayload(t *testing.T) {
+	payload := []byte(`{"version":"1","platform":"python"}`)
+	tests := []struct {
+		name            string
+		contentEncoding []string
+		body            func(t *testing.T) []byte
+		maxBodySize     int64
+		want            int
+	}{
+		{
+			name: "no encoding",
+			body: func(_ *testing.T) []byte { return payload },
+			want: http.StatusOK,
+		},
+		{
+			name:            "identity",
+			contentEncoding: []string{"identity"},
+			body:            func(_ *testing.T) []byte { return payload },
+			want:            http.StatusOK,
+		},
+		{
+			name:            "brotli",
+			contentEncoding: []string{"br"},
+			body:            func(t *testing.T) []byte { return encode(t, "br", payload) },
+			want:            http.StatusOK,
+		},
+		{
+			name:            "gzip",
+			contentEncoding: []string{"gzip"},
+			body:            func(t *testing.T) []byte { return encode(t, "gzip", payload) },
+			want:            http.StatusOK,
+		},
+		{
+			name:            "deflate",
+			contentEncoding: []string{"deflate"},
+			body:            func(t *testing.T) []byte { return encode(t, "deflate", payload) },
+			want:            http.StatusOK,
+		},
+		{
+			name:            "zstd",
+			contentEncoding: []string{"ZSTD"},
+			body:            func(t *testing.T) []byte { return encode(t, "zstd", payload) },
+			want:            http.StatusOK,
+		},
+		{
+			name:            "lz4",
+			contentEncoding: []string{"lz4"},
+			body:            func(t *testing.T) []byte { return encode(t, "lz4", payload) },
+			want:            http.StatusOK,
+		},
+		{
+			name:            "stacked in one header",
+			contentEncoding: []string{"zstd, gzip"},
+			body:            func(t *testing.T) []byte { return encode(t, "gzip", encode(t, "zstd", payload)) },
+			want:            http.StatusOK,
+		},
+		{
+			name:            "stacked in several headers",
+			contentEncoding: []string{"gzip", "br"},
+			body:            func(t *testing.T) []byte { return encode(t, "br", encode(t, "gzip", payload)) },
+			want:            http.StatusOK,
+		},
+		{
+			name:            "zstd window within the limit",
+			contentEncoding: []string{"zstd"},
+			body:            func(_ *testing.T) []byte { return zstdFrame(20, payload) },
+			want:            http.StatusOK,
+		},
+		{
+			name:            "zstd window over the limit",
+			contentEncoding: []string{"zstd"},
+			body:            func(_ *testing.T) []byte { return zstdFrame(29, payload) },
+			want:            http.StatusBadRequest,
+		},
+		{
+			name:            "unknown encoding",
+			contentEncoding: []string{"gzip, compress"},
+			body:            func(t *testing.T) []byte { return encode(t, "gzip", payload) },
+			want:            http.StatusUnsupportedMediaType,
+		},
+		{
+			name:            "too many encodings",
+			contentEncoding: []string{"gzip, gzip, gzip"},
+			body: func(t *testing.T) []byte {
+				return encode(t, "gzip", encode(t, "gzip", encode(t, "gzip", payload)))
+			},
+			want: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:            "body too large for the decoder header",
+			contentEncoding: []string{"gzip"},
+			body:            func(t *testing.T) []byte { return encode(t, "gzip", payload) },
+			maxBodySize:     4,
+			want:            http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:            "invalid gzip body",
+			contentEncoding: []string{"gzip"},
+			body:            func(_ *testing.T) []byte { return payload },
+			want:            http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var received []byte
+			var handler http.Handler = DecompressPayload(1<<20, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var err error
+				received, err = io.ReadAll(r.Body)
+				if err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			handler = LimitBody(test.maxBodySize, handler)
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(test.body(t)))
+			for _, encoding := range test.contentEncoding {
+				req.Header.Add("Content-Encoding", encoding)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			if w.Code != test.want {
+				t.Fatalf("expected status %d, got %d: %s", test.want, w.Code, w.Body.String())
+			}
+			if test.want == http.StatusOK && !bytes.Equal(received, payload) {
+				t.Fatalf("expected %s, got %s", payload, received)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package httputil
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/getsentry/vroom/internal/ratelimit"
+	"github.com/getsentry/vroom/internal/telemetry"
+)
+
+// RateLimit rejects the requests of organizations over their limit, using
+// the organization_id parameter of the route.
+func RateLimit(limiter *ratelimit.Limiter, handler http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ps := httprouter.ParamsFromContext(r.Context())
+		organizationID, err := strconv.ParseUint(ps.ByName("organization_id"), 10, 64)
+		if err == nil {
+			if ok, retryAfter := limiter.Allow(organizationID, 0); !ok {
+				WriteRateLimited(w, telemetry.RateLimitRead, retryAfter)
+				return
+			}
+		}
+		handler.ServeHTTP(w, r)
+	}
+}
+
+// WriteRateLimited rejects a request with a 429 telling the client when to
+// retry and counts the rejection.
+func WriteRateLimited(w http.ResponseWriter, scope string, retryAfter time.Duration) {
+	telemetry.RateLimitedRequests.WithLabelValues(scope).Inc()
+	seconds := int(math.Max(1, math.Ceil(retryAfter.Seconds())))
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	w.WriteHeader(http.StatusTooManyRequests)
+}
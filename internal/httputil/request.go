@@ -7,6 +7,8 @@ import (
 
 	"github.com/getsentry/sentry-go"
 	"github.com/julienschmidt/httprouter"
+
+	"github.com/getsentry/vroom/internal/storageutil"
 )
 
 // GetRequiredQueryParameters attempts to read the specified query parameters
@@ -42,3 +44,11 @@ func AnonymizeTransactionName(handler http.Handler) http.HandlerFunc {
 		handler.ServeHTTP(w, r)
 	}
 }
+
+// WithReadOptions schedules the storage reads of the request with the options.
+func WithReadOptions(options storageutil.ReadOptions, handler http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := storageutil.WithReadOptions(r.Context(), options)
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	}
+}
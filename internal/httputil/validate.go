@@ -0,0 +1,49 @@
+package httputil
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/getsentry/vroom/internal/openapi"
+)
+
+// ValidateRequest rejects requests not matching the operation with a 400
+// listing the reasons.
+func ValidateRequest(operation *openapi.Operation, handler http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		errors := operation.ValidateParameters(r)
+		if operation.ValidatesBody() {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				if IsBodyTooLarge(err) {
+					w.WriteHeader(http.StatusRequestEntityTooLarge)
+					return
+				}
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			errors = append(errors, operation.ValidateBody(body)...)
+		}
+		if len(errors) > 0 {
+			writeValidationErrors(w, errors)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}
+}
+
+func writeValidationErrors(w http.ResponseWriter, errors []openapi.Error) {
+	b, err := json.Marshal(struct {
+		Errors []openapi.Error `json:"errors"`
+	}{Errors: errors})
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_, _ = w.Write(b)
+}
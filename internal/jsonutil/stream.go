@@ -0,0 +1,195 @@
+// Package jsonutil writes large JSON documents without building them in
+// memory.
+package jsonutil
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+// checkInterval is the number of values written between two checks of the
+// context.
+const checkInterval = 1024
+
+// StreamWriter writes a JSON document piece by piece. It stops writing after
+// the first error, including the context being done when the client went
+// away, and reports it on Flush.
+type StreamWriter struct {
+	ctx context.Context
+	w   *bufio.Writer
+	err error
+	buf []byte
+
+	// empty tracks whether each open object or array has no element yet.
+	empty []bool
+	// afterKey is set when a key was written and its value is expected.
+	afterKey bool
+	writes   int
+}
+
+func NewStreamWriter(ctx context.Context, w io.Writer) *StreamWriter {
+	return &StreamWriter{
+		ctx: ctx,
+		w:   bufio.NewWriterSize(w, 64*1024),
+	}
+}
+
+func (sw *StreamWriter) write(b []byte) {
+	if sw.err != nil {
+		return
+	}
+	_, sw.err = sw.w.Write(b)
+}
+
+func (sw *StreamWriter) writeString(s string) {
+	if sw.err != nil {
+		return
+	}
+	_, sw.err = sw.w.WriteString(s)
+}
+
+// element starts a value, separating it from the previous element of its
+// array or object.
+func (sw *StreamWriter) element() {
+	sw.writes++
+	if sw.err == nil && sw.writes%checkInterval == 0 {
+		sw.err = sw.ctx.Err()
+	}
+	if sw.afterKey {
+		sw.afterKey = false
+		return
+	}
+	if len(sw.empty) == 0 {
+		return
+	}
+	last := len(sw.empty) - 1
+	if sw.empty[last] {
+		sw.empty[last] = false
+		return
+	}
+	sw.writeString(",")
+}
+
+func (sw *StreamWriter) BeginObject() {
+	sw.element()
+	sw.writeString("{")
+	sw.empty = append(sw.empty, true)
+}
+
+func (sw *StreamWriter) EndObject() {
+	sw.writeString("}")
+	sw.empty = sw.empty[:len(sw.empty)-1]
+}
+
+func (sw *StreamWriter) BeginArray() {
+	sw.element()
+	sw.writeString("[")
+	sw.empty = append(sw.empty, true)
+}
+
+func (sw *StreamWriter) EndArray() {
+	sw.writeString("]")
+	sw.empty = sw.empty[:len(sw.empty)-1]
+}
+
+// Key writes the name of a field of the current object. Its value has to be
+// written next.
+func (sw *StreamWriter) Key(name string) {
+	sw.element()
+	sw.buf = strconv.AppendQuote(sw.buf[:0], name)
+	sw.buf = append(sw.buf, ':')
+	sw.write(sw.buf)
+	sw.afterKey = true
+}
+
+// Value writes v as encoded by encoding/json. It's meant for small values,
+// large ones should be written element by element.
+func (sw *StreamWriter) Value(v interface{}) {
+	sw.element()
+	if sw.err != nil {
+		return
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		sw.err = err
+		return
+	}
+	sw.write(b)
+}
+
+// Field writes a field with a small value.
+func (sw *StreamWriter) Field(name string, v interface{}) {
+	sw.Key(name)
+	sw.Value(v)
+}
+
+func (sw *StreamWriter) Null() {
+	sw.element()
+	sw.writeString("null")
+}
+
+func (sw *StreamWriter) Int(v int) {
+	sw.element()
+	sw.buf = strconv.AppendInt(sw.buf[:0], int64(v), 10)
+	sw.write(sw.buf)
+}
+
+func (sw *StreamWriter) Uint64(v uint64) {
+	sw.element()
+	sw.buf = strconv.AppendUint(sw.buf[:0], v, 10)
+	sw.write(sw.buf)
+}
+
+// Ints writes the slice as an array or null when it's nil, like
+// encoding/json.
+func (sw *StreamWriter) Ints(values []int) {
+	if values == nil {
+		sw.Null()
+		return
+	}
+	sw.BeginArray()
+	for _, v := range values {
+		sw.Int(v)
+	}
+	sw.EndArray()
+}
+
+func (sw *StreamWriter) IntSlices(values [][]int) {
+	if values == nil {
+		sw.Null()
+		return
+	}
+	sw.BeginArray()
+	for _, v := range values {
+		sw.Ints(v)
+	}
+	sw.EndArray()
+}
+
+func (sw *StreamWriter) Uint64s(values []uint64) {
+	if values == nil {
+		sw.Null()
+		return
+	}
+	sw.BeginArray()
+	for _, v := range values {
+		sw.Uint64(v)
+	}
+	sw.EndArray()
+}
+
+// Err returns the first error encountered.
+func (sw *StreamWriter) Err() error {
+	return sw.err
+}
+
+// Flush writes the buffered data and returns the first error encountered.
+func (sw *StreamWriter) Flush() error {
+	if sw.err != nil {
+		return sw.err
+	}
+	return sw.w.Flush()
+}
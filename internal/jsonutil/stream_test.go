@@ -0,0 +1,57 @@
+package jsonutil
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestStreamWriter(t *testing.T) {
+	var b bytes.Buffer
+	sw := NewStreamWriter(context.Background(), &b)
+	sw.BeginObject()
+	sw.Field("name", "a<b")
+	sw.Key("samples")
+	sw.IntSlices([][]int{{1, 2}, {}, nil})
+	sw.Key("weights")
+	sw.Uint64s(nil)
+	sw.Key("nested")
+	sw.BeginArray()
+	sw.BeginObject()
+	sw.Field("a", 1)
+	sw.EndObject()
+	sw.BeginObject()
+	sw.EndObject()
+	sw.EndArray()
+	sw.EndObject()
+	if err := sw.Flush(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `{"name":"a\u003cb","samples":[[1,2],[],null],"weights":null,"nested":[{"a":1},{}]}`
+	if b.String() != expected {
+		t.Fatalf("expected %s, got %s", expected, b.String())
+	}
+}
+
+func TestStreamWriterStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var b bytes.Buffer
+	sw := NewStreamWriter(ctx, &b)
+	sw.BeginArray()
+	for i := 0; i < checkInterval; i++ {
+		sw.Int(i)
+	}
+	cancel()
+	for i := 0; i < 10*checkInterval; i++ {
+		sw.Int(i)
+	}
+	sw.EndArray()
+	if err := sw.Flush(); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if b.Len() != 0 {
+		t.Fatalf("expected nothing to be written, got %d bytes", b.Len())
+	}
+}
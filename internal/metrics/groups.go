@@ -0,0 +1,163 @@
+package metrics
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/getsentry/vroom/internal/chunk"
+	"github.com/getsentry/vroom/internal/profile"
+	"github.com/getsentry/vroom/internal/utils"
+)
+
+type (
+	// Dimension is a profile attribute metrics can be grouped by.
+	Dimension string
+
+	// AggregatorGroup aggregates functions for profiles sharing the same
+	// dimension values.
+	AggregatorGroup struct {
+		Dimensions map[Dimension]string
+		Aggregator Aggregator
+	}
+)
+
+const (
+	DimensionDeviceClassification Dimension = "device_classification"
+	DimensionDeviceModel          Dimension = "device_model"
+	DimensionEnvironment          Dimension = "environment"
+	DimensionOSVersion            Dimension = "os_version"
+	DimensionPlatform             Dimension = "platform"
+	DimensionRelease              Dimension = "release"
+	DimensionTransactionName      Dimension = "transaction_name"
+
+	// maxGroups caps the number of groups, profiles not fitting in them are
+	// aggregated under the otherGroupValue dimension values.
+	maxGroups       = 50
+	otherGroupValue = "other"
+)
+
+var dimensions = map[Dimension]struct{}{
+	DimensionDeviceClassification: {},
+	DimensionDeviceModel:          {},
+	DimensionEnvironment:          {},
+	DimensionOSVersion:            {},
+	DimensionPlatform:             {},
+	DimensionRelease:              {},
+	DimensionTransactionName:      {},
+}
+
+func IsValidDimension(d Dimension) bool {
+	_, exists := dimensions[d]
+	return exists
+}
+
+func profileDimensions(p profile.Profile, groupBy []Dimension) map[Dimension]string {
+	values := make(map[Dimension]string, len(groupBy))
+	for _, d := range groupBy {
+		switch d {
+		case DimensionDeviceClassification:
+			values[d] = p.Metadata().DeviceClassification
+		case DimensionDeviceModel:
+			values[d] = p.Metadata().DeviceModel
+		case DimensionEnvironment:
+			values[d] = p.Environment()
+		case DimensionOSVersion:
+			values[d] = p.Metadata().DeviceOSVersion
+		case DimensionPlatform:
+			values[d] = string(p.Platform())
+		case DimensionRelease:
+			values[d] = p.Release()
+		case DimensionTransactionName:
+			values[d] = p.Transaction().Name
+		}
+	}
+	return values
+}
+
+// chunkDimensions returns the dimensions known for a chunk, device and
+// transaction information are not stored in chunks.
+func chunkDimensions(c chunk.Chunk, groupBy []Dimension) map[Dimension]string {
+	values := make(map[Dimension]string, len(groupBy))
+	for _, d := range groupBy {
+		switch d {
+		case DimensionEnvironment:
+			values[d] = c.Environment
+		case DimensionPlatform:
+			values[d] = string(c.Platform)
+		case DimensionRelease:
+			values[d] = c.Release
+		default:
+			values[d] = ""
+		}
+	}
+	return values
+}
+
+func groupKey(groupBy []Dimension, values map[Dimension]string) string {
+	var b strings.Builder
+	for _, d := range groupBy {
+		b.WriteString(values[d])
+		// Dimension values are user controlled, use a separator they
+		// are very unlikely to contain.
+		b.WriteByte(0)
+	}
+	return b.String()
+}
+
+func (ma *Aggregator) group(values map[Dimension]string) *AggregatorGroup {
+	if ma.Groups == nil {
+		ma.Groups = make(map[string]*AggregatorGroup)
+	}
+	key := groupKey(ma.GroupBy, values)
+	if g, exists := ma.Groups[key]; exists {
+		return g
+	}
+	if len(ma.Groups) >= maxGroups {
+		values = make(map[Dimension]string, len(ma.GroupBy))
+		for _, d := range ma.GroupBy {
+			values[d] = otherGroupValue
+		}
+		key = groupKey(ma.GroupBy, values)
+		if g, exists := ma.Groups[key]; exists {
+			return g
+		}
+	}
+	a := NewAggregator(ma.MaxUniqueFunctions, ma.MaxNumOfExamples)
+	a.Quantiles = ma.Quantiles
+	a.IncludeSketches = ma.IncludeSketches
+	a.RankBy = ma.RankBy
+	a.Interval = ma.Interval
+	g := &AggregatorGroup{
+		Dimensions: values,
+		Aggregator: a,
+	}
+	ma.Groups[key] = g
+	return g
+}
+
+// ToGroupsMetrics returns the metrics of each group for the functions
+// passed, usually the ones returned by ToMetrics.
+func (ma *Aggregator) ToGroupsMetrics(functions []utils.FunctionMetrics) []utils.FunctionMetricsGroup {
+	fingerprints := make(map[uint32]struct{}, len(functions))
+	for _, f := range functions {
+		fingerprints[uint32(f.Fingerprint)] = struct{}{}
+	}
+	keys := make([]string, 0, len(ma.Groups))
+	for k := range ma.Groups {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	groups := make([]utils.FunctionMetricsGroup, 0, len(ma.Groups))
+	for _, k := range keys {
+		g := ma.Groups[k]
+		values := make(map[string]string, len(g.Dimensions))
+		for d, v := range g.Dimensions {
+			values[string(d)] = v
+		}
+		groups = append(groups, utils.FunctionMetricsGroup{
+			Dimensions:       values,
+			FunctionsMetrics: g.Aggregator.toMetrics(fingerprints),
+		})
+	}
+	return groups
+}
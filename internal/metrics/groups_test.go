@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/testutil"
+	"github.com/getsentry/vroom/internal/utils"
+)
+
+func TestToGroupsMetrics(t *testing.T) {
+	ma := NewAggregator(100, 5)
+	ma.GroupBy = []Dimension{DimensionDeviceClassification, DimensionRelease}
+
+	functions := func(selfTimeNS uint64) []nodetree.CallTreeFunction {
+		return []nodetree.CallTreeFunction{
+			{Function: "a", Fingerprint: 0, SelfTimesNS: []uint64{selfTimeNS}, SumSelfTimeNS: selfTimeNS, SampleCount: 1},
+			{Function: "b", Fingerprint: 1, SelfTimesNS: []uint64{10}, SumSelfTimeNS: 10, SampleCount: 1},
+		}
+	}
+	add := func(classification, release string, selfTimeNS uint64, profileID string) {
+		example := utils.ExampleMetadata{ProfileID: profileID}
+		fs := functions(selfTimeNS)
+		ma.AddFunctions(fs, example)
+		g := ma.group(map[Dimension]string{
+			DimensionDeviceClassification: classification,
+			DimensionRelease:              release,
+		})
+		g.Aggregator.AddFunctions(fs, example)
+	}
+	add("low", "1.0", 100, "1")
+	add("low", "1.0", 100, "2")
+	add("high", "1.0", 10, "3")
+
+	// Only return the group metrics for function a.
+	global := ma.ToMetrics()[:1]
+	want := []utils.FunctionMetricsGroup{
+		{
+			Dimensions: map[string]string{"device_classification": "high", "release": "1.0"},
+			FunctionsMetrics: []utils.FunctionMetrics{
+				{
+					Name:     "a",
+					P75:      10,
+					P95:      10,
+					P99:      10,
+					Avg:      10,
+					Sum:      10,
+					Count:    1,
+					Worst:    utils.ExampleMetadata{ProfileID: "3"},
+					Examples: []utils.ExampleMetadata{{ProfileID: "3"}},
+				},
+			},
+		},
+		{
+			Dimensions: map[string]string{"device_classification": "low", "release": "1.0"},
+			FunctionsMetrics: []utils.FunctionMetrics{
+				{
+					Name:     "a",
+					P75:      100,
+					P95:      100,
+					P99:      100,
+					Avg:      100,
+					Sum:      200,
+					Count:    2,
+					Worst:    utils.ExampleMetadata{ProfileID: "1"},
+					Examples: []utils.ExampleMetadata{{ProfileID: "1"}, {ProfileID: "2"}},
+				},
+			},
+		},
+	}
+	if diff := testutil.Diff(ma.ToGroupsMetrics(global), want); diff != "" {
+		t.Fatalf("Result mismatch: got - want +\n%s", diff)
+	}
+}
+
+func TestGroupsAreCapped(t *testing.T) {
+	ma := NewAggregator(100, 5)
+	ma.GroupBy = []Dimension{DimensionRelease}
+	for i := 0; i < maxGroups+10; i++ {
+		ma.group(map[Dimension]string{DimensionRelease: fmt.Sprintf("1.%d", i)})
+	}
+	if len(ma.Groups) != maxGroups+1 {
+		t.Fatalf("expected %d groups, got %d", maxGroups+1, len(ma.Groups))
+	}
+	if _, exists := ma.Groups[groupKey(ma.GroupBy, map[Dimension]string{DimensionRelease: otherGroupValue})]; !exists {
+		t.Fatalf("expected an other group")
+	}
+}
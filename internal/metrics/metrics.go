@@ -5,11 +5,14 @@ import (
 	"errors"
 	"math"
 	"sort"
+	"strconv"
+	"time"
 
 	"github.com/getsentry/sentry-go"
 	"github.com/getsentry/vroom/internal/chunk"
 	"github.com/getsentry/vroom/internal/nodetree"
 	"github.com/getsentry/vroom/internal/profile"
+	"github.com/getsentry/vroom/internal/sketch"
 	"github.com/getsentry/vroom/internal/storageutil"
 	"github.com/getsentry/vroom/internal/utils"
 	"gocloud.dev/blob"
@@ -27,24 +30,69 @@ type (
 		MaxNumOfExamples   uint
 		CallTreeFunctions  map[uint32]nodetree.CallTreeFunction
 		FunctionsMetadata  map[uint32]FunctionsMetadata
+		// Sketches holds the distribution of self times for each function
+		// instead of keeping all of them.
+		Sketches map[uint32]*sketch.DDSketch
+
+		// Quantiles are computed in addition to p75, p95 and p99.
+		Quantiles []float64
+		// IncludeSketches adds the serialized sketches to the metrics so
+		// they can be merged with other results.
+		IncludeSketches bool
+
+		// GroupBy splits the aggregation in Groups by dimension values, on
+		// top of the global one.
+		GroupBy []Dimension
+		Groups  map[string]*AggregatorGroup
+
+		// RankBy is the time used to order functions, RankBySelfTime by
+		// default.
+		RankBy string
+
+		// Interval enables a time series of each function in buckets of
+		// this duration.
+		Interval time.Duration
+		Series   map[uint32]map[int64]*SeriesBucket
+		// SeriesStarts are the starts of the buckets of all functions.
+		SeriesStarts map[int64]struct{}
 	}
 )
 
+const (
+	RankBySelfTime  = "self_time"
+	RankByTotalTime = "total_time"
+)
+
 func NewAggregator(MaxUniqueFunctions uint, MaxNumOfExamples uint) Aggregator {
 	return Aggregator{
 		MaxUniqueFunctions: MaxUniqueFunctions,
 		MaxNumOfExamples:   MaxNumOfExamples,
 		CallTreeFunctions:  make(map[uint32]nodetree.CallTreeFunction),
 		FunctionsMetadata:  make(map[uint32]FunctionsMetadata),
+		Sketches:           make(map[uint32]*sketch.DDSketch),
 	}
 }
 
+// QuantileName returns the key used for a quantile in the metrics,
+// 0.999 being p99.9.
+func QuantileName(q float64) string {
+	return "p" + strconv.FormatFloat(q*100, 'f', -1, 64)
+}
+
 func (ma *Aggregator) AddFunctions(functions []nodetree.CallTreeFunction, resultMetadata utils.ExampleMetadata) {
 	for _, f := range functions {
+		s, ok := ma.Sketches[f.Fingerprint]
+		if !ok {
+			s = sketch.NewDefault()
+			ma.Sketches[f.Fingerprint] = s
+		}
+		for _, v := range f.SelfTimesNS {
+			s.Add(float64(v))
+		}
 		if fn, ok := ma.CallTreeFunctions[f.Fingerprint]; ok {
 			fn.SampleCount += f.SampleCount
-			fn.SelfTimesNS = append(fn.SelfTimesNS, f.SelfTimesNS...)
 			fn.SumSelfTimeNS += f.SumSelfTimeNS
+			fn.TotalTimeNS += f.TotalTimeNS
 			funcMetadata := ma.FunctionsMetadata[f.Fingerprint]
 			if f.SumSelfTimeNS > funcMetadata.MaxVal {
 				funcMetadata.MaxVal = f.SumSelfTimeNS
@@ -56,6 +104,8 @@ func (ma *Aggregator) AddFunctions(functions []nodetree.CallTreeFunction, result
 			ma.FunctionsMetadata[f.Fingerprint] = funcMetadata
 			ma.CallTreeFunctions[f.Fingerprint] = fn
 		} else {
+			// Self times are kept in the sketch.
+			f.SelfTimesNS = nil
 			ma.CallTreeFunctions[f.Fingerprint] = f
 			ma.FunctionsMetadata[f.Fingerprint] = FunctionsMetadata{
 				MaxVal:   f.SumSelfTimeNS,
@@ -67,31 +117,56 @@ func (ma *Aggregator) AddFunctions(functions []nodetree.CallTreeFunction, result
 }
 
 func (ma *Aggregator) ToMetrics() []utils.FunctionMetrics {
+	return ma.toMetrics(nil)
+}
+
+// toMetrics only returns metrics for the fingerprints passed, if any.
+func (ma *Aggregator) toMetrics(fingerprints map[uint32]struct{}) []utils.FunctionMetrics {
 	metrics := make([]utils.FunctionMetrics, 0, len(ma.CallTreeFunctions))
 
 	for _, f := range ma.CallTreeFunctions {
-		sort.Slice(f.SelfTimesNS, func(i, j int) bool {
-			return f.SelfTimesNS[i] < f.SelfTimesNS[j]
-		})
-		p75, _ := quantile(f.SelfTimesNS, 0.75)
-		p95, _ := quantile(f.SelfTimesNS, 0.95)
-		p99, _ := quantile(f.SelfTimesNS, 0.99)
-		metrics = append(metrics, utils.FunctionMetrics{
+		if fingerprints != nil {
+			if _, ok := fingerprints[f.Fingerprint]; !ok {
+				continue
+			}
+		}
+		s, ok := ma.Sketches[f.Fingerprint]
+		if !ok || s.Count() == 0 {
+			continue
+		}
+		fm := utils.FunctionMetrics{
 			Name:        f.Function,
 			Package:     f.Package,
 			Fingerprint: uint64(f.Fingerprint),
 			InApp:       f.InApp,
-			P75:         p75,
-			P95:         p95,
-			P99:         p99,
-			Avg:         float64(f.SumSelfTimeNS) / float64(len(f.SelfTimesNS)),
+			P75:         sketchQuantile(s, 0.75),
+			P95:         sketchQuantile(s, 0.95),
+			P99:         sketchQuantile(s, 0.99),
+			Avg:         float64(f.SumSelfTimeNS) / float64(s.Count()),
 			Sum:         f.SumSelfTimeNS,
+			TotalSum:    f.TotalTimeNS,
 			Count:       uint64(f.SampleCount),
 			Worst:       ma.FunctionsMetadata[f.Fingerprint].Worst,
 			Examples:    ma.FunctionsMetadata[f.Fingerprint].Examples,
-		})
+		}
+		if len(ma.Quantiles) > 0 {
+			fm.Quantiles = make(map[string]uint64, len(ma.Quantiles))
+			for _, q := range ma.Quantiles {
+				fm.Quantiles[QuantileName(q)] = sketchQuantile(s, q)
+			}
+		}
+		if ma.IncludeSketches {
+			fm.Sketch = s
+		}
+		if ma.Interval > 0 {
+			fm.Series = ma.toSeries(f.Fingerprint)
+		}
+		metrics = append(metrics, fm)
 	}
 	sort.Slice(metrics, func(i, j int) bool {
+		if ma.RankBy == RankByTotalTime {
+			return metrics[i].TotalSum > metrics[j].TotalSum
+		}
 		return metrics[i].Sum > metrics[j].Sum
 	})
 	if len(metrics) > int(ma.MaxUniqueFunctions) {
@@ -100,15 +175,35 @@ func (ma *Aggregator) ToMetrics() []utils.FunctionMetrics {
 	return metrics
 }
 
-func quantile(values []uint64, q float64) (uint64, error) {
-	if len(values) == 0 {
-		return 0, errors.New("cannot compute percentile from empty list")
+// addResult adds the functions of a profile to the aggregation, its groups
+// and time series. dimensions is only called when grouping.
+func (ma *Aggregator) addResult(
+	functions []nodetree.CallTreeFunction,
+	example utils.ExampleMetadata,
+	timestamp time.Time,
+	dimensions func() map[Dimension]string,
+) error {
+	ma.AddFunctions(functions, example)
+	var g *AggregatorGroup
+	if len(ma.GroupBy) > 0 {
+		g = ma.group(dimensions())
+		g.Aggregator.AddFunctions(functions, example)
+	}
+	if ma.Interval == 0 {
+		return nil
+	}
+	if err := ma.addToSeries(functions, timestamp); err != nil {
+		return err
 	}
-	if q <= 0 || q > 1.0 {
-		return 0, errors.New("q must be a value between 0 and 1.0")
+	if g != nil {
+		return g.Aggregator.addToSeries(functions, timestamp)
 	}
-	index := int(math.Ceil(float64(len(values))*q)) - 1
-	return values[index], nil
+	return nil
+}
+
+func sketchQuantile(s *sketch.DDSketch, q float64) uint64 {
+	v, _ := s.Quantile(q)
+	return uint64(math.Round(v))
 }
 
 func ExtractFunctionsFromCallTrees(
@@ -124,7 +219,7 @@ func ExtractFunctionsFromCallTrees(
 
 	functionsList := make([]nodetree.CallTreeFunction, 0, len(functions))
 	for _, function := range functions {
-		if function.SampleCount <= 1 {
+		if len(function.SelfTimesNS) > 0 && function.SampleCount <= 1 {
 			// if there's only ever a single sample for this function in
 			// the profile, we skip over it to reduce the amount of data
 			continue
@@ -140,16 +235,13 @@ func ExtractFunctionsFromCallTrees(
 	return functionsList
 }
 
+// CapAndFilterFunctions returns the first functions with a self-time, the ones
+// sent to Kafka and aggregated in sketches, only keeping application functions
+// when filterSystemFrames is set.
 func CapAndFilterFunctions(functions []nodetree.CallTreeFunction, maxUniqueFunctionsPerProfile int, filterSystemFrames bool) []nodetree.CallTreeFunction {
-	if !filterSystemFrames {
-		if len(functions) > maxUniqueFunctionsPerProfile {
-			return functions[:maxUniqueFunctionsPerProfile]
-		}
-		return functions
-	}
 	appFunctions := make([]nodetree.CallTreeFunction, 0, min(maxUniqueFunctionsPerProfile, len(functions)))
 	for _, f := range functions {
-		if !f.InApp {
+		if len(f.SelfTimesNS) == 0 || (filterSystemFrames && !f.InApp) {
 			continue
 		}
 		appFunctions = append(appFunctions, f)
@@ -160,34 +252,46 @@ func CapAndFilterFunctions(functions []nodetree.CallTreeFunction, maxUniqueFunct
 	return appFunctions
 }
 
+// extractFunctions returns the top application functions of a profile or a
+// chunk according to RankBy.
+func (ma *Aggregator) extractFunctions(callTrees map[uint64][]*nodetree.Node) []nodetree.CallTreeFunction {
+	functions := ExtractFunctionsFromCallTrees(callTrees)
+	if ma.RankBy == RankByTotalTime {
+		sort.SliceStable(functions, func(i, j int) bool {
+			return functions[i].TotalTimeNS > functions[j].TotalTimeNS
+		})
+	}
+	return CapAndFilterFunctions(functions, int(ma.MaxUniqueFunctions), true)
+}
+
 func (ma *Aggregator) GetMetricsFromCandidates(
 	ctx context.Context,
 	storage *blob.Bucket,
 	organizationID uint64,
 	transactionProfileCandidates []utils.TransactionProfileCandidate,
 	continuousProfileCandidates []utils.ContinuousProfileCandidate,
-	jobs chan storageutil.ReadJob,
+	jobs *storageutil.Scheduler,
 ) ([]utils.FunctionMetrics, error) {
 	hub := sentry.GetHubFromContext(ctx)
 
 	numCandidates := len(transactionProfileCandidates) + len(continuousProfileCandidates)
 
 	results := make(chan storageutil.ReadJobResult, numCandidates)
-	defer close(results)
+	batch := make([]storageutil.ReadJob, 0, cap(results))
 
 	for _, candidate := range transactionProfileCandidates {
-		jobs <- profile.ReadJob{
+		batch = append(batch, profile.ReadJob{
 			Ctx:            ctx,
 			OrganizationID: organizationID,
 			ProjectID:      candidate.ProjectID,
 			ProfileID:      candidate.ProfileID,
 			Storage:        storage,
 			Result:         results,
-		}
+		})
 	}
 
 	for _, candidate := range continuousProfileCandidates {
-		jobs <- chunk.ReadJob{
+		batch = append(batch, chunk.ReadJob{
 			Ctx:            ctx,
 			OrganizationID: organizationID,
 			ProjectID:      candidate.ProjectID,
@@ -199,7 +303,11 @@ func (ma *Aggregator) GetMetricsFromCandidates(
 			End:            candidate.End,
 			Storage:        storage,
 			Result:         results,
-		}
+		})
+	}
+
+	if err := jobs.Submit(ctx, batch...); err != nil {
+		return nil, err
 	}
 
 	for i := 0; i < numCandidates; i++ {
@@ -227,8 +335,13 @@ func (ma *Aggregator) GetMetricsFromCandidates(
 				continue
 			}
 			resultMetadata = utils.NewExampleFromProfileID(result.Profile.ProjectID(), result.Profile.ID())
-			functions := CapAndFilterFunctions(ExtractFunctionsFromCallTrees(profileCallTrees), int(ma.MaxUniqueFunctions), true)
-			ma.AddFunctions(functions, resultMetadata)
+			functions := ma.extractFunctions(profileCallTrees)
+			err = ma.addResult(functions, resultMetadata, result.Profile.Timestamp(), func() map[Dimension]string {
+				return profileDimensions(result.Profile, ma.GroupBy)
+			})
+			if err != nil {
+				return nil, err
+			}
 		} else if result, ok := res.(chunk.ReadJobResult); ok {
 			chunkCallTrees, err := result.Chunk.CallTrees(result.ThreadID)
 			if err != nil {
@@ -261,8 +374,13 @@ func (ma *Aggregator) GetMetricsFromCandidates(
 				result.Start,
 				result.End,
 			)
-			functions := CapAndFilterFunctions(ExtractFunctionsFromCallTrees(intChunkCallTrees), int(ma.MaxUniqueFunctions), true)
-			ma.AddFunctions(functions, resultMetadata)
+			functions := ma.extractFunctions(intChunkCallTrees)
+			err = ma.addResult(functions, resultMetadata, chunkTimestamp(result.Chunk, result.Start), func() map[Dimension]string {
+				return chunkDimensions(result.Chunk, ma.GroupBy)
+			})
+			if err != nil {
+				return nil, err
+			}
 		} else {
 			// this should never happen
 			return nil, errors.New("unexpected result from storage")
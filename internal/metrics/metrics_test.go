@@ -4,11 +4,22 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/sketch"
 	"github.com/getsentry/vroom/internal/testutil"
 	"github.com/getsentry/vroom/internal/utils"
 )
 
+func newSketch(values ...uint64) *sketch.DDSketch {
+	s := sketch.NewDefault()
+	for _, v := range values {
+		s.Add(float64(v))
+	}
+	return s
+}
+
 func TestAggregatorAddFunctions(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -38,16 +49,18 @@ func TestAggregatorAddFunctions(t *testing.T) {
 					0: {
 						Function:      "a",
 						Fingerprint:   0,
-						SelfTimesNS:   []uint64{10, 5, 25, 10, 5, 25},
 						SumSelfTimeNS: 80,
 					},
 					1: {
 						Function:      "b",
 						Fingerprint:   1,
-						SelfTimesNS:   []uint64{45, 60, 45, 60},
 						SumSelfTimeNS: 210,
 					},
 				},
+				Sketches: map[uint32]*sketch.DDSketch{
+					0: newSketch(10, 5, 25, 10, 5, 25),
+					1: newSketch(45, 60, 45, 60),
+				},
 				FunctionsMetadata: map[uint32]FunctionsMetadata{
 					0: {
 						MaxVal:   40,
@@ -70,7 +83,7 @@ func TestAggregatorAddFunctions(t *testing.T) {
 		// ID 1 and the second one with ID 2
 		ma.AddFunctions(test.calltreeFunctions, utils.ExampleMetadata{ProfileID: "1"})
 		ma.AddFunctions(test.calltreeFunctions, utils.ExampleMetadata{ProfileID: "2"})
-		if diff := testutil.Diff(ma, test.want); diff != "" {
+		if diff := testutil.Diff(ma, test.want, cmp.AllowUnexported(sketch.DDSketch{})); diff != "" {
 			t.Fatalf("Result mismatch: got - want +\n%s", diff)
 		}
 	}
@@ -90,18 +103,21 @@ func TestAggregatorToMetrics(t *testing.T) {
 					0: {
 						Function:      "a",
 						Fingerprint:   0,
-						SelfTimesNS:   []uint64{1, 2, 3, 4, 10, 8, 7, 11, 20},
 						SumSelfTimeNS: 66,
 						SampleCount:   2,
 					},
 					1: {
 						Function:      "b",
 						Fingerprint:   1,
-						SelfTimesNS:   []uint64{1, 2, 3, 4, 10, 8, 7, 11, 20},
 						SumSelfTimeNS: 66,
 						SampleCount:   2,
 					},
 				}, //end callTreeFunctions
+				Sketches: map[uint32]*sketch.DDSketch{
+					0: newSketch(1, 2, 3, 4, 10, 8, 7, 11, 20),
+					1: newSketch(1, 2, 3, 4, 10, 8, 7, 11, 20),
+				},
+				Quantiles: []float64{0.5, 0.999},
 				FunctionsMetadata: map[uint32]FunctionsMetadata{
 					0: {
 						MaxVal:   66,
@@ -122,6 +138,7 @@ func TestAggregatorToMetrics(t *testing.T) {
 					P75:         10,
 					P95:         20,
 					P99:         20,
+					Quantiles:   map[string]uint64{"p50": 7, "p99.9": 20},
 					Count:       2,
 					Sum:         66,
 					Avg:         float64(66) / float64(9),
@@ -134,6 +151,7 @@ func TestAggregatorToMetrics(t *testing.T) {
 					P75:         10,
 					P95:         20,
 					P99:         20,
+					Quantiles:   map[string]uint64{"p50": 7, "p99.9": 20},
 					Count:       2,
 					Sum:         66,
 					Avg:         float64(66) / float64(9),
@@ -154,3 +172,80 @@ func TestAggregatorToMetrics(t *testing.T) {
 		}
 	}
 }
+
+func TestAggregatorRankByTotalTime(t *testing.T) {
+	ma := NewAggregator(100, 5)
+	ma.RankBy = RankByTotalTime
+	ma.AddFunctions([]nodetree.CallTreeFunction{
+		{
+			Function:      "a",
+			Fingerprint:   0,
+			SelfTimesNS:   []uint64{50},
+			SumSelfTimeNS: 50,
+			TotalTimeNS:   50,
+		},
+		{
+			Function:      "b",
+			Fingerprint:   1,
+			SelfTimesNS:   []uint64{10},
+			SumSelfTimeNS: 10,
+			TotalTimeNS:   100,
+		},
+	}, utils.ExampleMetadata{ProfileID: "1"})
+
+	metrics := ma.ToMetrics()
+	got := make([]string, 0, len(metrics))
+	for _, m := range metrics {
+		got = append(got, m.Name)
+	}
+	if diff := testutil.Diff(got, []string{"b", "a"}); diff != "" {
+		t.Fatalf("Result mismatch: got - want +\n%s", diff)
+	}
+	if metrics[0].TotalSum != 100 || metrics[0].Sum != 10 {
+		t.Fatalf("unexpected metrics: %+v", metrics[0])
+	}
+}
+
+func TestCapAndFilterFunctions(t *testing.T) {
+	functions := []nodetree.CallTreeFunction{
+		{Function: "a", InApp: true, TotalTimeNS: 100},
+		{Function: "b", InApp: true, SelfTimesNS: []uint64{20}, SumSelfTimeNS: 20, TotalTimeNS: 50},
+		{Function: "c", InApp: false, SelfTimesNS: []uint64{10}, SumSelfTimeNS: 10, TotalTimeNS: 10},
+		{Function: "d", InApp: true, SelfTimesNS: []uint64{5}, SumSelfTimeNS: 5, TotalTimeNS: 5},
+	}
+	tests := []struct {
+		name               string
+		max                int
+		filterSystemFrames bool
+		want               []string
+	}{
+		{
+			name: "skip functions without self-time",
+			max:  10,
+			want: []string{"b", "c", "d"},
+		},
+		{
+			name:               "filter system frames",
+			max:                10,
+			filterSystemFrames: true,
+			want:               []string{"b", "d"},
+		},
+		{
+			name: "cap",
+			max:  2,
+			want: []string{"b", "c"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := make([]string, 0, len(functions))
+			for _, f := range CapAndFilterFunctions(functions, test.max, test.filterSystemFrames) {
+				got = append(got, f.Function)
+			}
+			if diff := testutil.Diff(got, test.want); diff != "" {
+				t.Fatalf("Result mismatch: got - want +\n%s", diff)
+			}
+		})
+	}
+}
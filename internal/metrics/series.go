@@ -0,0 +1,100 @@
+package metrics
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/getsentry/vroom/internal/chunk"
+	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/sketch"
+	"github.com/getsentry/vroom/internal/utils"
+)
+
+// MinSeriesInterval and MaxSeriesBuckets bound the memory used by the time
+// series, a sketch is kept for each function and bucket.
+const (
+	MinSeriesInterval = time.Minute
+	MaxSeriesBuckets  = 1000
+)
+
+// ErrTooManySeriesBuckets is returned when the profiles span more than
+// MaxSeriesBuckets intervals.
+var ErrTooManySeriesBuckets = errors.New("metrics: too many series buckets")
+
+// SeriesBucket aggregates the self times of a function for profiles
+// starting in the same interval.
+type SeriesBucket struct {
+	SampleCount uint64
+	Sketch      *sketch.DDSketch
+}
+
+// addToSeries adds the functions to the bucket of the interval containing
+// timestamp.
+func (ma *Aggregator) addToSeries(functions []nodetree.CallTreeFunction, timestamp time.Time) error {
+	if ma.Series == nil {
+		ma.Series = make(map[uint32]map[int64]*SeriesBucket)
+		ma.SeriesStarts = make(map[int64]struct{})
+	}
+	start := timestamp.Truncate(ma.Interval).Unix()
+	if _, exists := ma.SeriesStarts[start]; !exists {
+		if len(ma.SeriesStarts) >= MaxSeriesBuckets {
+			return ErrTooManySeriesBuckets
+		}
+		ma.SeriesStarts[start] = struct{}{}
+	}
+	for _, f := range functions {
+		buckets, exists := ma.Series[f.Fingerprint]
+		if !exists {
+			buckets = make(map[int64]*SeriesBucket)
+			ma.Series[f.Fingerprint] = buckets
+		}
+		b, exists := buckets[start]
+		if !exists {
+			b = &SeriesBucket{Sketch: sketch.NewDefault()}
+			buckets[start] = b
+		}
+		b.SampleCount += uint64(f.SampleCount)
+		for _, v := range f.SelfTimesNS {
+			b.Sketch.Add(float64(v))
+		}
+	}
+	return nil
+}
+
+// toSeries returns the buckets of a function sorted by time. Intervals
+// without any profile are omitted.
+func (ma *Aggregator) toSeries(fingerprint uint32) []utils.FunctionMetricsBucket {
+	buckets, exists := ma.Series[fingerprint]
+	if !exists {
+		return nil
+	}
+	series := make([]utils.FunctionMetricsBucket, 0, len(buckets))
+	for start, b := range buckets {
+		if b.Sketch.Count() == 0 {
+			continue
+		}
+		series = append(series, utils.FunctionMetricsBucket{
+			Start: start,
+			P50:   sketchQuantile(b.Sketch, 0.5),
+			P95:   sketchQuantile(b.Sketch, 0.95),
+			Count: b.SampleCount,
+		})
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].Start < series[j].Start
+	})
+	return series
+}
+
+// chunkTimestamp returns the start of the candidate if set, the start of the
+// chunk otherwise.
+func chunkTimestamp(c chunk.Chunk, start uint64) time.Time {
+	if start > 0 {
+		return time.Unix(0, int64(start))
+	}
+	s, _ := c.StartEndTimestamps()
+	seconds, fraction := math.Modf(s)
+	return time.Unix(int64(seconds), int64(fraction*1e9))
+}
@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/testutil"
+	"github.com/getsentry/vroom/internal/utils"
+)
+
+func TestSeries(t *testing.T) {
+	ma := NewAggregator(100, 5)
+	ma.Interval = time.Hour
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	add := func(timestamp time.Time, selfTimesNS ...uint64) {
+		var sum uint64
+		for _, v := range selfTimesNS {
+			sum += v
+		}
+		functions := []nodetree.CallTreeFunction{
+			{
+				Function:      "a",
+				SelfTimesNS:   selfTimesNS,
+				SumSelfTimeNS: sum,
+				SampleCount:   len(selfTimesNS),
+			},
+		}
+		err := ma.addResult(functions, utils.ExampleMetadata{ProfileID: "1"}, timestamp, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	add(start.Add(10*time.Minute), 10, 20)
+	add(start.Add(50*time.Minute), 30)
+	add(start.Add(3*time.Hour), 100)
+
+	metrics := ma.ToMetrics()
+	if len(metrics) != 1 {
+		t.Fatalf("expected 1 function, got %d", len(metrics))
+	}
+	want := []utils.FunctionMetricsBucket{
+		{Start: start.Unix(), P50: 20, P95: 30, Count: 3},
+		{Start: start.Add(3 * time.Hour).Unix(), P50: 100, P95: 100, Count: 1},
+	}
+	if diff := testutil.Diff(metrics[0].Series, want); diff != "" {
+		t.Fatalf("Result mismatch: got - want +\n%s", diff)
+	}
+}
+
+func TestGroupedSeries(t *testing.T) {
+	ma := NewAggregator(100, 5)
+	ma.Interval = time.Hour
+	ma.GroupBy = []Dimension{DimensionRelease}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	add := func(timestamp time.Time, release string, selfTimeNS uint64) {
+		functions := []nodetree.CallTreeFunction{
+			{
+				Function:      "a",
+				SelfTimesNS:   []uint64{selfTimeNS},
+				SumSelfTimeNS: selfTimeNS,
+				SampleCount:   1,
+			},
+		}
+		dimensions := func() map[Dimension]string {
+			return map[Dimension]string{DimensionRelease: release}
+		}
+		err := ma.addResult(functions, utils.ExampleMetadata{ProfileID: "1"}, timestamp, dimensions)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	add(start, "1.0", 10)
+	add(start.Add(2*time.Hour), "2.0", 20)
+
+	groups := ma.ToGroupsMetrics(ma.ToMetrics())
+	if len(groups) != 2 {
+		t.Fatalf("expected 2 groups, got %d", len(groups))
+	}
+	want := [][]utils.FunctionMetricsBucket{
+		{{Start: start.Unix(), P50: 10, P95: 10, Count: 1}},
+		{{Start: start.Add(2 * time.Hour).Unix(), P50: 20, P95: 20, Count: 1}},
+	}
+	for i, g := range groups {
+		if diff := testutil.Diff(g.FunctionsMetrics[0].Series, want[i]); diff != "" {
+			t.Fatalf("Result mismatch for %v: got - want +\n%s", g.Dimensions, diff)
+		}
+	}
+}
+
+func TestSeriesBucketsAreCapped(t *testing.T) {
+	ma := NewAggregator(100, 5)
+	ma.Interval = MinSeriesInterval
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	functions := []nodetree.CallTreeFunction{
+		{Function: "a", SelfTimesNS: []uint64{10}, SumSelfTimeNS: 10, SampleCount: 1},
+	}
+	for i := 0; i < MaxSeriesBuckets; i++ {
+		err := ma.addToSeries(functions, start.Add(time.Duration(i)*MinSeriesInterval))
+		if err != nil {
+			t.Fatalf("unexpected error for bucket %d: %v", i, err)
+		}
+	}
+	// Existing buckets can still be added to.
+	if err := ma.addToSeries(functions, start); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err := ma.addToSeries(functions, start.Add(MaxSeriesBuckets*MinSeriesInterval))
+	if !errors.Is(err, ErrTooManySeriesBuckets) {
+		t.Fatalf("expected ErrTooManySeriesBuckets, got %v", err)
+	}
+}
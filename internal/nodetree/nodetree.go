@@ -104,11 +104,15 @@ type CallTreeFunction struct {
 	InApp         bool     `json:"in_app"`
 	SelfTimesNS   []uint64 `json:"self_times_ns"`
 	SumSelfTimeNS uint64   `json:"-"`
-	SampleCount   int      `json:"-"`
+	// TotalTimeNS is the inclusive duration of the function. Frames nested
+	// under a frame of the same function aren't counted again.
+	TotalTimeNS uint64 `json:"total_time_ns"`
+	SampleCount int    `json:"-"`
 }
 
-// `CollectionFunctions` walks the node tree, collects any function with a non zero
-// self-time and writes them into the `results` parameter.
+// `CollectionFunctions` walks the node tree, collects any aggregated function and
+// writes them into the `results` parameter. Functions only calling others have no
+// self-time, they're collected for their total time.
 //
 // The meaning of self-time is slightly modified here to adapt better for our use case.
 //
@@ -125,15 +129,39 @@ type CallTreeFunction struct {
 // children with durations 20ms, 30ms, and 40ms, and they are system, application, system
 // functions respectively, the self-time of `bar` will be 70ms because
 // 100ms - 30ms = 70ms.
+//
+// The total time of a function is the duration of its outermost frames, in order
+// not to count the time of recursive calls several times. Self-times and sample
+// counts are only credited to the frames with a non zero self-time.
 func (n *Node) CollectFunctions(
 	results map[uint32]CallTreeFunction,
+) (uint64, uint64) {
+	return n.collectFunctions(results, make(map[uint32]int))
+}
+
+// collectFunctions keeps track of the functions on the stack in `onStack`.
+func (n *Node) collectFunctions(
+	results map[uint32]CallTreeFunction,
+	onStack map[uint32]int,
 ) (uint64, uint64) {
 	var childrenApplicationDurationNS uint64
 	var childrenSystemDurationNS uint64
 
+	aggregateFrame := shouldAggregateFrame(n.Frame)
+
+	var fingerprint uint32
+	if aggregateFrame {
+		// casting to an uint32 here because snuba does not handle uint64 values
+		// well as it is converted to a float somewhere
+		// not changing to the 32 bit hash function here to preserve backwards
+		// compatibility with existing fingerprints that we can cast
+		fingerprint = n.Frame.Fingerprint()
+		onStack[fingerprint]++
+	}
+
 	// determine the amount of time spent in application vs system functions in the children
 	for _, child := range n.Children {
-		applicationDurationNS, systemDurationNS := child.CollectFunctions(results)
+		applicationDurationNS, systemDurationNS := child.collectFunctions(results, onStack)
 		childrenApplicationDurationNS += applicationDurationNS
 		childrenSystemDurationNS += systemDurationNS
 	}
@@ -148,7 +176,9 @@ func (n *Node) CollectFunctions(
 
 	var selfTimeNS uint64
 
-	if shouldAggregateFrame(n.Frame) {
+	if aggregateFrame {
+		onStack[fingerprint]--
+
 		if n.IsApplication {
 			// cannot use `n.DurationNS - childrenApplicationDurationNS > 0` in case it underflows
 			if n.DurationNS > childrenApplicationDurationNS {
@@ -168,31 +198,25 @@ func (n *Node) CollectFunctions(
 			}
 		}
 
-		if selfTimeNS > 0 {
-			// casting to an uint32 here because snuba does not handle uint64 values
-			// well as it is converted to a float somewhere
-			// not changing to the 32 bit hash function here to preserve backwards
-			// compatibility with existing fingerprints that we can cast
-			fingerprint := n.Frame.Fingerprint()
-
-			function, exists := results[fingerprint]
-			if !exists {
-				results[fingerprint] = CallTreeFunction{
-					Fingerprint:   fingerprint,
-					Function:      n.Frame.Function,
-					Package:       n.Frame.ModuleOrPackage(),
-					InApp:         n.IsApplication,
-					SelfTimesNS:   []uint64{selfTimeNS},
-					SumSelfTimeNS: selfTimeNS,
-					SampleCount:   n.SampleCount,
-				}
-			} else {
-				function.SelfTimesNS = append(function.SelfTimesNS, selfTimeNS)
-				function.SumSelfTimeNS += selfTimeNS
-				function.SampleCount += n.SampleCount
-				results[fingerprint] = function
+		function, exists := results[fingerprint]
+		if !exists {
+			function = CallTreeFunction{
+				Fingerprint: fingerprint,
+				Function:    n.Frame.Function,
+				Package:     n.Frame.ModuleOrPackage(),
+				InApp:       n.IsApplication,
 			}
 		}
+		// only the outermost frame of a recursive function counts toward its total time
+		if onStack[fingerprint] == 0 {
+			function.TotalTimeNS += n.DurationNS
+		}
+		if selfTimeNS > 0 {
+			function.SelfTimesNS = append(function.SelfTimesNS, selfTimeNS)
+			function.SumSelfTimeNS += selfTimeNS
+			function.SampleCount += n.SampleCount
+		}
+		results[fingerprint] = function
 	}
 
 	// this pair represents the time spent in application functions vs
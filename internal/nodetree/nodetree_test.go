@@ -42,6 +42,7 @@ func TestNodeTreeCollectFunctions(t *testing.T) {
 					Package:       "foo",
 					SelfTimesNS:   []uint64{10},
 					SumSelfTimeNS: 10,
+					TotalTimeNS:   10,
 				},
 			},
 		},
@@ -64,6 +65,7 @@ func TestNodeTreeCollectFunctions(t *testing.T) {
 					Package:       "foo",
 					SelfTimesNS:   []uint64{10},
 					SumSelfTimeNS: 10,
+					TotalTimeNS:   10,
 				},
 			},
 		},
@@ -96,6 +98,7 @@ func TestNodeTreeCollectFunctions(t *testing.T) {
 					Package:       "foo",
 					SelfTimesNS:   []uint64{10},
 					SumSelfTimeNS: 10,
+					TotalTimeNS:   20,
 				},
 				fingerprintBar: {
 					Fingerprint:   fingerprintBar,
@@ -104,6 +107,7 @@ func TestNodeTreeCollectFunctions(t *testing.T) {
 					Package:       "bar",
 					SelfTimesNS:   []uint64{10},
 					SumSelfTimeNS: 10,
+					TotalTimeNS:   10,
 				},
 			},
 		},
@@ -156,6 +160,14 @@ func TestNodeTreeCollectFunctions(t *testing.T) {
 					Package:       "foo",
 					SelfTimesNS:   []uint64{10},
 					SumSelfTimeNS: 10,
+					TotalTimeNS:   10,
+				},
+				fingerprintBar: {
+					Fingerprint: fingerprintBar,
+					InApp:       false,
+					Function:    "bar",
+					Package:     "bar",
+					TotalTimeNS: 10,
 				},
 				fingerprintBaz: {
 					Fingerprint:   fingerprintBaz,
@@ -164,6 +176,14 @@ func TestNodeTreeCollectFunctions(t *testing.T) {
 					Package:       "baz",
 					SelfTimesNS:   []uint64{10},
 					SumSelfTimeNS: 10,
+					TotalTimeNS:   10,
+				},
+				fingerprintMain: {
+					Fingerprint: fingerprintMain,
+					InApp:       true,
+					Function:    "main",
+					Package:     "main",
+					TotalTimeNS: 10,
 				},
 			},
 		},
@@ -260,6 +280,14 @@ func TestNodeTreeCollectFunctions(t *testing.T) {
 					Package:       "foo",
 					SelfTimesNS:   []uint64{10, 20},
 					SumSelfTimeNS: 30,
+					TotalTimeNS:   30,
+				},
+				fingerprintBar: {
+					Fingerprint: fingerprintBar,
+					InApp:       false,
+					Function:    "bar",
+					Package:     "bar",
+					TotalTimeNS: 30,
 				},
 				fingerprintBaz: {
 					Fingerprint:   fingerprintBaz,
@@ -268,6 +296,7 @@ func TestNodeTreeCollectFunctions(t *testing.T) {
 					Package:       "baz",
 					SelfTimesNS:   []uint64{10, 20},
 					SumSelfTimeNS: 30,
+					TotalTimeNS:   30,
 				},
 				fingerprintQux: {
 					Fingerprint:   fingerprintQux,
@@ -276,6 +305,7 @@ func TestNodeTreeCollectFunctions(t *testing.T) {
 					Package:       "qux",
 					SelfTimesNS:   []uint64{10},
 					SumSelfTimeNS: 10,
+					TotalTimeNS:   10,
 				},
 				fingerprintMain: {
 					Fingerprint:   fingerprintMain,
@@ -284,6 +314,7 @@ func TestNodeTreeCollectFunctions(t *testing.T) {
 					Package:       "main",
 					SelfTimesNS:   []uint64{10},
 					SumSelfTimeNS: 10,
+					TotalTimeNS:   40,
 				},
 			},
 		},
@@ -336,6 +367,7 @@ func TestNodeTreeCollectFunctions(t *testing.T) {
 					InApp:         true,
 					SelfTimesNS:   []uint64{10},
 					SumSelfTimeNS: 10,
+					TotalTimeNS:   10,
 				},
 			},
 		},
@@ -370,6 +402,99 @@ func TestNodeTreeCollectFunctions(t *testing.T) {
 					InApp:         true,
 					SelfTimesNS:   []uint64{10},
 					SumSelfTimeNS: 10,
+					TotalTimeNS:   10,
+				},
+			},
+		},
+		{
+			name:     "recursive function",
+			platform: platform.Python,
+			node: Node{
+				DurationNS:    30,
+				IsApplication: true,
+				Frame: frame.Frame{
+					Function: "foo",
+					Package:  "foo",
+				},
+				Children: []*Node{
+					{
+						DurationNS:    20,
+						IsApplication: true,
+						Frame: frame.Frame{
+							Function: "bar",
+							Package:  "bar",
+						},
+						Children: []*Node{
+							{
+								DurationNS:    10,
+								IsApplication: true,
+								Frame: frame.Frame{
+									Function: "foo",
+									Package:  "foo",
+								},
+							},
+						},
+					},
+				},
+			},
+			want: map[uint32]CallTreeFunction{
+				fingerprintFoo: {
+					Fingerprint:   fingerprintFoo,
+					InApp:         true,
+					Function:      "foo",
+					Package:       "foo",
+					SelfTimesNS:   []uint64{10, 10},
+					SumSelfTimeNS: 20,
+					TotalTimeNS:   30,
+				},
+				fingerprintBar: {
+					Fingerprint:   fingerprintBar,
+					InApp:         true,
+					Function:      "bar",
+					Package:       "bar",
+					SelfTimesNS:   []uint64{10},
+					SumSelfTimeNS: 10,
+					TotalTimeNS:   20,
+				},
+			},
+		},
+		{
+			name:     "caller without self-time",
+			platform: platform.Python,
+			node: Node{
+				DurationNS:    100,
+				IsApplication: true,
+				Frame: frame.Frame{
+					Function: "main",
+					Package:  "main",
+				},
+				Children: []*Node{
+					{
+						DurationNS:    100,
+						IsApplication: true,
+						Frame: frame.Frame{
+							Function: "foo",
+							Package:  "foo",
+						},
+					},
+				},
+			},
+			want: map[uint32]CallTreeFunction{
+				fingerprintMain: {
+					Fingerprint: fingerprintMain,
+					InApp:       true,
+					Function:    "main",
+					Package:     "main",
+					TotalTimeNS: 100,
+				},
+				fingerprintFoo: {
+					Fingerprint:   fingerprintFoo,
+					InApp:         true,
+					Function:      "foo",
+					Package:       "foo",
+					SelfTimesNS:   []uint64{100},
+					SumSelfTimeNS: 100,
+					TotalTimeNS:   100,
 				},
 			},
 		},
@@ -0,0 +1,218 @@
+package occurrence
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/getsentry/vroom/internal/category"
+	"github.com/getsentry/vroom/internal/frame"
+	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/profile"
+)
+
+type (
+	// AppStartFunction is an in-app function contributing to the app start,
+	// ranked by the self time it spent before the first frame was rendered.
+	AppStartFunction struct {
+		Fingerprint uint32 `json:"fingerprint"`
+		Function    string `json:"function"`
+		Package     string `json:"package"`
+		SampleCount int    `json:"sample_count"`
+		SelfTimeNS  uint64 `json:"self_time_ns"`
+	}
+
+	appStartStats struct {
+		durationNS  uint64
+		thresholdNS uint64
+		startType   string
+		windowEndNS uint64
+	}
+)
+
+const (
+	AppStartColdOp = "app.start.cold"
+	AppStartWarmOp = "app.start.warm"
+	// AppStartTransactionName is the transaction name used by SDKs not
+	// setting a specific operation for app start transactions.
+	AppStartTransactionName = "app.start"
+
+	maxAppStartBreakdownFunctions = 10
+)
+
+var defaultAppStartThresholds = map[string]time.Duration{
+	AppStartColdOp:          2 * time.Second,
+	AppStartWarmOp:          1 * time.Second,
+	AppStartTransactionName: 2 * time.Second,
+}
+
+// appStartType returns the kind of app start the profile is covering
+// or an empty string if it's not an app start profile.
+func appStartType(p profile.Profile) string {
+	switch op := p.TransactionMetadata().TransactionOp; op {
+	case AppStartColdOp, AppStartWarmOp:
+		return op
+	}
+	if p.Transaction().Name == AppStartTransactionName {
+		return AppStartTransactionName
+	}
+	return ""
+}
+
+func newAppStartStats(p profile.Profile, startType string, overrides detectionOverrides) appStartStats {
+	s := appStartStats{
+		durationNS:  p.DurationNS(),
+		thresholdNS: uint64(overrides.durationThreshold(category.AppStart, defaultAppStartThresholds[startType])),
+		startType:   startType,
+	}
+	tm := p.TransactionMetadata()
+	// The app start transaction ends when the first frame is rendered, we
+	// prefer its duration over the profile's one since the profiler is
+	// usually started after the process.
+	if !tm.TransactionStart.IsZero() && tm.TransactionEnd.After(tm.TransactionStart) {
+		s.durationNS = uint64(tm.TransactionEnd.Sub(tm.TransactionStart))
+	}
+	s.windowEndNS = s.durationNS
+	if !tm.TransactionEnd.IsZero() && tm.TransactionEnd.After(p.Timestamp()) {
+		s.windowEndNS = uint64(tm.TransactionEnd.Sub(p.Timestamp()))
+	}
+	return s
+}
+
+func appStartBreakdown(callTrees []*nodetree.Node, overrides detectionOverrides) []AppStartFunction {
+	functions := make(map[uint32]nodetree.CallTreeFunction)
+	for _, root := range callTrees {
+		root.CollectFunctions(functions)
+	}
+	breakdown := make([]AppStartFunction, 0, len(functions))
+	for _, f := range functions {
+		if !f.InApp || f.SumSelfTimeNS == 0 || overrides.isMuted(f.Package, f.Function) {
+			continue
+		}
+		breakdown = append(breakdown, AppStartFunction{
+			Fingerprint: f.Fingerprint,
+			Function:    f.Function,
+			Package:     f.Package,
+			SampleCount: f.SampleCount,
+			SelfTimeNS:  f.SumSelfTimeNS,
+		})
+	}
+	sort.SliceStable(breakdown, func(i, j int) bool {
+		if breakdown[i].SelfTimeNS == breakdown[j].SelfTimeNS {
+			return breakdown[i].Fingerprint < breakdown[j].Fingerprint
+		}
+		return breakdown[i].SelfTimeNS > breakdown[j].SelfTimeNS
+	})
+	if len(breakdown) > maxAppStartBreakdownFunctions {
+		breakdown = breakdown[:maxAppStartBreakdownFunctions]
+	}
+	return breakdown
+}
+
+// clipCallTrees returns a copy of the call trees where nodes starting after
+// endNS are removed and durations are capped at endNS.
+func clipCallTrees(callTrees []*nodetree.Node, endNS uint64) []*nodetree.Node {
+	clipped := make([]*nodetree.Node, 0, len(callTrees))
+	for _, n := range callTrees {
+		if c := clipNode(n, endNS); c != nil {
+			clipped = append(clipped, c)
+		}
+	}
+	return clipped
+}
+
+func clipNode(n *nodetree.Node, endNS uint64) *nodetree.Node {
+	if n.StartNS >= endNS {
+		return nil
+	}
+	c := *n
+	if c.EndNS > endNS {
+		c.EndNS = endNS
+		c.DurationNS = c.EndNS - c.StartNS
+	}
+	if len(n.Children) > 0 {
+		c.Children = clipCallTrees(n.Children, endNS)
+	}
+	return &c
+}
+
+func findAppStartCause(
+	p profile.Profile,
+	callTreesPerThreadID map[uint64][]*nodetree.Node,
+	overrides detectionOverrides,
+	occurrences *[]*Occurrence,
+) {
+	if overrides.isDisabled(category.AppStart) {
+		return
+	}
+	startType := appStartType(p)
+	if startType == "" {
+		return
+	}
+	callTrees, exists := callTreesPerThreadID[p.Transaction().ActiveThreadID]
+	if !exists {
+		return
+	}
+	stats := newAppStartStats(p, startType, overrides)
+	if stats.thresholdNS == 0 || stats.durationNS < stats.thresholdNS {
+		return
+	}
+	callTrees = clipCallTrees(callTrees, stats.windowEndNS)
+	breakdown := appStartBreakdown(callTrees, overrides)
+	if len(breakdown) == 0 {
+		return
+	}
+	var ni *nodeInfo
+	for _, root := range callTrees {
+		st := make([]frame.Frame, 0, profile.MaxStackDepth)
+		if ni = findNodeInfoByFingerprint(root, breakdown[0].Fingerprint, &st); ni != nil {
+			break
+		}
+	}
+	if ni == nil {
+		return
+	}
+	ni.Category = category.AppStart
+	o := NewOccurrence(p, *ni)
+	// The subtitle holds the normalized name of the suspect function.
+	o.Culprit = o.Subtitle
+	o.EvidenceData["app_start_breakdown"] = breakdown
+	o.EvidenceData["app_start_duration_ns"] = stats.durationNS
+	o.EvidenceData["app_start_threshold_ns"] = stats.thresholdNS
+	o.EvidenceData["app_start_type"] = stats.startType
+	o.EvidenceDisplay = append(o.EvidenceDisplay, Evidence{
+		Name: EvidenceNameAppStartDuration,
+		Value: fmt.Sprintf(
+			"%s (threshold: %s)",
+			time.Duration(stats.durationNS).Round(time.Millisecond),
+			time.Duration(stats.thresholdNS),
+		),
+	})
+	*occurrences = append(*occurrences, o)
+}
+
+func findNodeInfoByFingerprint(
+	n *nodetree.Node,
+	fingerprint uint32,
+	st *[]frame.Frame,
+) *nodeInfo {
+	*st = append(*st, n.ToFrame())
+	defer func() {
+		*st = (*st)[:len(*st)-1]
+	}()
+	if n.Frame.Fingerprint() == fingerprint {
+		ni := nodeInfo{
+			Node:       *n,
+			StackTrace: make([]frame.Frame, len(*st)),
+		}
+		ni.Node.Children = nil
+		copy(ni.StackTrace, *st)
+		return &ni
+	}
+	for _, c := range n.Children {
+		if ni := findNodeInfoByFingerprint(c, fingerprint, st); ni != nil {
+			return ni
+		}
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package occurrence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getsentry/vroom/internal/category"
+	"github.com/getsentry/vroom/internal/frame"
+	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/platform"
+	"github.com/getsentry/vroom/internal/profile"
+	"github.com/getsentry/vroom/internal/sample"
+	"github.com/getsentry/vroom/internal/testutil"
+	"github.com/getsentry/vroom/internal/transaction"
+	"github.com/getsentry/vroom/internal/utils"
+)
+
+func newAppStartCallTrees() map[uint64][]*nodetree.Node {
+	return map[uint64][]*nodetree.Node{
+		1: {
+			{
+				DurationNS:    uint64(4 * time.Second),
+				EndNS:         uint64(4 * time.Second),
+				IsApplication: false,
+				Name:          "main",
+				Package:       "system",
+				Frame: frame.Frame{
+					Function: "main",
+					InApp:    &testutil.False,
+					Package:  "system",
+				},
+				SampleCount: 400,
+				Children: []*nodetree.Node{
+					{
+						DurationNS:    uint64(2 * time.Second),
+						EndNS:         uint64(2 * time.Second),
+						IsApplication: true,
+						Name:          "loadConfig",
+						Package:       "app",
+						Frame: frame.Frame{
+							Function: "loadConfig",
+							InApp:    &testutil.True,
+							Package:  "app",
+						},
+						SampleCount: 200,
+					},
+					{
+						DurationNS:    uint64(2 * time.Second),
+						EndNS:         uint64(4 * time.Second),
+						IsApplication: true,
+						Name:          "setupViews",
+						Package:       "app",
+						StartNS:       uint64(2 * time.Second),
+						Frame: frame.Frame{
+							Function: "setupViews",
+							InApp:    &testutil.True,
+							Package:  "app",
+						},
+						SampleCount: 200,
+					},
+				},
+			},
+		},
+	}
+}
+
+func newAppStartProfile(name, op string, start time.Time, duration time.Duration, options utils.Options) profile.Profile {
+	return profile.New(&sample.Profile{
+		RawProfile: sample.RawProfile{
+			EventID:   "1234567890",
+			Options:   options,
+			Platform:  platform.Cocoa,
+			Timestamp: start,
+			Transaction: transaction.Transaction{
+				ActiveThreadID: 1,
+				ID:             "1234",
+				Name:           name,
+			},
+			TransactionMetadata: transaction.Metadata{
+				TransactionOp:    op,
+				TransactionStart: start,
+				TransactionEnd:   start.Add(duration),
+			},
+		},
+	})
+}
+
+func TestFindAppStartCause(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name            string
+		profile         profile.Profile
+		wantCulprit     string
+		wantBreakdown   []AppStartFunction
+		wantOccurrences int
+	}{
+		{
+			name:            "Slow cold start blames the dominant function before the first frame",
+			profile:         newAppStartProfile("app.start", AppStartColdOp, start, 3*time.Second, utils.Options{}),
+			wantOccurrences: 1,
+			wantCulprit:     "loadConfig",
+			wantBreakdown: []AppStartFunction{
+				{
+					Fingerprint: frame.Frame{Function: "loadConfig", Package: "app"}.Fingerprint(),
+					Function:    "loadConfig",
+					Package:     "app",
+					SampleCount: 200,
+					SelfTimeNS:  uint64(2 * time.Second),
+				},
+				{
+					Fingerprint: frame.Frame{Function: "setupViews", Package: "app"}.Fingerprint(),
+					Function:    "setupViews",
+					Package:     "app",
+					SampleCount: 200,
+					SelfTimeNS:  uint64(time.Second),
+				},
+			},
+		},
+		{
+			name:            "Fast warm start",
+			profile:         newAppStartProfile("app.start", AppStartWarmOp, start, 500*time.Millisecond, utils.Options{}),
+			wantOccurrences: 0,
+		},
+		{
+			name: "Project threshold is higher than the app start",
+			profile: newAppStartProfile("app.start", AppStartColdOp, start, 3*time.Second, utils.Options{
+				Detection: utils.DetectionOptions{
+					DurationThresholdsMS: map[string]uint64{string(category.AppStart): 5000},
+				},
+			}),
+			wantOccurrences: 0,
+		},
+		{
+			name:            "Not an app start",
+			profile:         newAppStartProfile("MainViewController", "ui.load", start, 3*time.Second, utils.Options{}),
+			wantOccurrences: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var occurrences []*Occurrence
+			findAppStartCause(tt.profile, newAppStartCallTrees(), newDetectionOverrides(tt.profile.GetOptions().Detection), &occurrences)
+			if len(occurrences) != tt.wantOccurrences {
+				t.Fatalf("expected %d occurrences, got %d", tt.wantOccurrences, len(occurrences))
+			}
+			if tt.wantOccurrences == 0 {
+				return
+			}
+			o := occurrences[0]
+			if o.Type != AppStartType {
+				t.Fatalf("occurrence type mismatch: got %v want %v", o.Type, AppStartType)
+			}
+			if o.Culprit != tt.wantCulprit {
+				t.Fatalf("culprit mismatch: got %v want %v", o.Culprit, tt.wantCulprit)
+			}
+			if diff := testutil.Diff(o.EvidenceData["app_start_breakdown"], tt.wantBreakdown); diff != "" {
+				t.Fatalf("Result mismatch: got - want +\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package occurrence
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getsentry/vroom/internal/telemetry"
+)
+
+type (
+	// DedupBackend counts occurrences per key and window. Implementations
+	// can be shared between instances to deduplicate across them.
+	DedupBackend interface {
+		// Increment adds one to the count of the key for the window and
+		// returns the new count.
+		Increment(key string, window int64) (int64, error)
+		// Count returns the count of the key for the window.
+		Count(key string, window int64) (int64, error)
+	}
+
+	// Deduplicator only lets the first occurrences with the same fingerprint
+	// and project go through in a window.
+	Deduplicator struct {
+		backend DedupBackend
+		limit   int64
+		window  time.Duration
+	}
+
+	// MemoryDedupBackend is a DedupBackend local to the process. It only
+	// keeps the current and previous windows.
+	MemoryDedupBackend struct {
+		mu     sync.Mutex
+		counts map[int64]map[string]int64
+	}
+)
+
+// EvidenceDataSuppressedDuplicates holds the number of occurrences dropped
+// in the previous window for the same fingerprint. Nothing carries the count
+// of a window not followed by an occurrence of the same fingerprint in the
+// next one, the vroom_occurrences_suppressed_total metric counts all of them.
+const EvidenceDataSuppressedDuplicates = "suppressed_duplicates"
+
+func NewDeduplicator(backend DedupBackend, window time.Duration, limit int64) *Deduplicator {
+	return &Deduplicator{
+		backend: backend,
+		limit:   limit,
+		window:  window,
+	}
+}
+
+func dedupKey(o *Occurrence) string {
+	return strconv.FormatUint(o.ProjectID, 10) + ":" + strings.Join(o.Fingerprint, ",")
+}
+
+// Filter returns the occurrences allowed in the current window. The first
+// occurrence of a window for a given key carries the number of duplicates
+// suppressed in the previous one.
+func (d *Deduplicator) Filter(occurrences []*Occurrence, now time.Time) ([]*Occurrence, error) {
+	window := now.UnixNano() / int64(d.window)
+	filtered := make([]*Occurrence, 0, len(occurrences))
+	for _, o := range occurrences {
+		key := dedupKey(o)
+		count, err := d.backend.Increment(key, window)
+		if err != nil {
+			return occurrences, err
+		}
+		if count > d.limit {
+			telemetry.OccurrencesSuppressed.WithLabelValues(string(o.Category())).Inc()
+			continue
+		}
+		if count == 1 {
+			previous, err := d.backend.Count(key, window-1)
+			if err != nil {
+				return occurrences, err
+			}
+			if suppressed := previous - d.limit; suppressed > 0 {
+				if o.EvidenceData == nil {
+					o.EvidenceData = make(map[string]interface{})
+				}
+				o.EvidenceData[EvidenceDataSuppressedDuplicates] = suppressed
+			}
+		}
+		filtered = append(filtered, o)
+	}
+	return filtered, nil
+}
+
+func NewMemoryDedupBackend() *MemoryDedupBackend {
+	return &MemoryDedupBackend{
+		counts: make(map[int64]map[string]int64),
+	}
+}
+
+func (b *MemoryDedupBackend) Increment(key string, window int64) (int64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	counts, exists := b.counts[window]
+	if !exists {
+		// Drop windows we won't need anymore.
+		for w := range b.counts {
+			if w < window-1 {
+				delete(b.counts, w)
+			}
+		}
+		counts = make(map[string]int64)
+		b.counts[window] = counts
+	}
+	counts[key]++
+	return counts[key], nil
+}
+
+func (b *MemoryDedupBackend) Count(key string, window int64) (int64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.counts[window][key], nil
+}
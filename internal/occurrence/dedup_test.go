@@ -0,0 +1,59 @@
+package occurrence
+
+import (
+	"testing"
+	"time"
+)
+
+func newDedupOccurrences(projectID uint64, fingerprint string, n int) []*Occurrence {
+	occurrences := make([]*Occurrence, 0, n)
+	for i := 0; i < n; i++ {
+		occurrences = append(occurrences, &Occurrence{
+			EvidenceData: map[string]interface{}{},
+			Fingerprint:  []string{fingerprint},
+			ProjectID:    projectID,
+		})
+	}
+	return occurrences
+}
+
+func TestDeduplicatorFilter(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := NewDeduplicator(NewMemoryDedupBackend(), time.Minute, 2)
+
+	occurrences := append(newDedupOccurrences(1, "a", 5), newDedupOccurrences(2, "a", 1)...)
+	filtered, err := d.Filter(occurrences, start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filtered) != 3 {
+		t.Fatalf("expected 3 occurrences, got %d", len(filtered))
+	}
+	for _, o := range filtered {
+		if _, exists := o.EvidenceData[EvidenceDataSuppressedDuplicates]; exists {
+			t.Fatalf("unexpected suppressed duplicates in the first window")
+		}
+	}
+
+	filtered, err = d.Filter(newDedupOccurrences(1, "a", 1), start.Add(10*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filtered) != 0 {
+		t.Fatalf("expected the occurrence to be suppressed, got %d", len(filtered))
+	}
+
+	filtered, err = d.Filter(newDedupOccurrences(1, "a", 3), start.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filtered) != 2 {
+		t.Fatalf("expected 2 occurrences, got %d", len(filtered))
+	}
+	if got := filtered[0].EvidenceData[EvidenceDataSuppressedDuplicates]; got != int64(4) {
+		t.Fatalf("suppressed duplicates mismatch: got %v want 4", got)
+	}
+	if _, exists := filtered[1].EvidenceData[EvidenceDataSuppressedDuplicates]; exists {
+		t.Fatalf("only the first occurrence of a window should carry the count")
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"log/slog"
 
+	"github.com/getsentry/vroom/internal/category"
 	"github.com/getsentry/vroom/internal/frame"
 	"github.com/getsentry/vroom/internal/nodetree"
 	"github.com/getsentry/vroom/internal/platform"
@@ -15,13 +16,13 @@ import (
 type (
 	DetectFrameOptions interface {
 		onlyCheckActiveThread() bool
-		checkNode(*nodetree.Node) *nodeInfo
+		checkNode(*nodetree.Node, detectionOverrides) *nodeInfo
 	}
 
 	DetectExactFrameOptions struct {
 		ActiveThreadOnly   bool
 		DurationThreshold  time.Duration
-		FunctionsByPackage map[string]map[string]Category
+		FunctionsByPackage map[string]map[string]category.Category
 
 		// SampleThreshold is the minimum number of samples in which we need to
 		// detect the frame in order to create an occurrence.
@@ -31,7 +32,7 @@ type (
 	DetectAndroidFrameOptions struct {
 		ActiveThreadOnly   bool
 		DurationThreshold  time.Duration
-		FunctionsByPackage map[string]map[string]Category
+		FunctionsByPackage map[string]map[string]category.Category
 
 		// SampleThreshold is the minimum number of samples in which we need to
 		// detect the frame in order to create an occurrence.
@@ -44,46 +45,17 @@ type (
 	}
 
 	nodeInfo struct {
-		Category   Category
+		Category   category.Category
 		Node       nodetree.Node
 		StackTrace []frame.Frame
 	}
 )
 
-const (
-	Base64Decode     Category = "base64_decode"
-	Base64Encode     Category = "base64_encode"
-	Compression      Category = "compression"
-	CoreDataBlock    Category = "core_data_block"
-	CoreDataMerge    Category = "core_data_merge"
-	CoreDataRead     Category = "core_data_read"
-	CoreDataWrite    Category = "core_data_write"
-	Decompression    Category = "decompression"
-	FileRead         Category = "file_read"
-	FileWrite        Category = "file_write"
-	HTTP             Category = "http"
-	ImageDecode      Category = "image_decode"
-	ImageEncode      Category = "image_encode"
-	JSONDecode       Category = "json_decode"
-	JSONEncode       Category = "json_encode"
-	MLModelInference Category = "ml_model_inference"
-	MLModelLoad      Category = "ml_model_load"
-	Regex            Category = "regex"
-	SQL              Category = "sql"
-	SourceContext    Category = "source_context"
-	ThreadWait       Category = "thread_wait"
-	ViewInflation    Category = "view_inflation"
-	ViewLayout       Category = "view_layout"
-	ViewRender       Category = "view_render"
-	ViewUpdate       Category = "view_update"
-	XPC              Category = "xpc"
-)
-
 func (options DetectExactFrameOptions) onlyCheckActiveThread() bool {
 	return options.ActiveThreadOnly
 }
 
-func (options DetectExactFrameOptions) checkNode(n *nodetree.Node) *nodeInfo {
+func (options DetectExactFrameOptions) checkNode(n *nodetree.Node, overrides detectionOverrides) *nodeInfo {
 	// Check if we have a list of functions associated to the package.
 	functions, exists := options.FunctionsByPackage[n.Package]
 	if !exists {
@@ -96,13 +68,18 @@ func (options DetectExactFrameOptions) checkNode(n *nodetree.Node) *nodeInfo {
 		return nil
 	}
 
+	// Check if the project opted out of this category or function.
+	if overrides.isDisabled(category) || overrides.isMuted(n.Package, n.Name) {
+		return nil
+	}
+
 	// Check if it's above the duration threshold.
-	if n.DurationNS < uint64(options.DurationThreshold) {
+	if n.DurationNS < uint64(overrides.durationThreshold(category, options.DurationThreshold)) {
 		return nil
 	}
 
 	// Check if it's above the sample threshold.
-	if n.SampleCount < options.SampleThreshold {
+	if n.SampleCount < overrides.sampleThreshold(category, options.SampleThreshold) {
 		return nil
 	}
 
@@ -118,7 +95,7 @@ func (options DetectAndroidFrameOptions) onlyCheckActiveThread() bool {
 	return options.ActiveThreadOnly
 }
 
-func (options DetectAndroidFrameOptions) checkNode(n *nodetree.Node) *nodeInfo {
+func (options DetectAndroidFrameOptions) checkNode(n *nodetree.Node, overrides detectionOverrides) *nodeInfo {
 	// Check if we have a list of functions associated to the package.
 	functions, exists := options.FunctionsByPackage[n.Package]
 	if !exists {
@@ -142,14 +119,20 @@ func (options DetectAndroidFrameOptions) checkNode(n *nodetree.Node) *nodeInfo {
 		return nil
 	}
 
+	// Check if the project opted out of this category or function.
+	if overrides.isDisabled(category) || overrides.isMuted(n.Package, name) {
+		slog.Debug("detection is disabled", slog.String("category", string(category)), slog.String("function", name))
+		return nil
+	}
+
 	// Check if it's above the duration threshold.
-	if n.DurationNS < uint64(options.DurationThreshold) {
+	if n.DurationNS < uint64(overrides.durationThreshold(category, options.DurationThreshold)) {
 		slog.Debug("duration is too small", slog.Uint64("duration_ns", n.DurationNS))
 		return nil
 	}
 
 	// Check if it's above the sample threshold.
-	if n.SampleCount < options.SampleThreshold {
+	if n.SampleCount < overrides.sampleThreshold(category, options.SampleThreshold) {
 		slog.Debug("sample count is too low", slog.Int("sample_count", n.SampleCount))
 		return nil
 	}
@@ -166,59 +149,59 @@ var detectFrameJobs = map[platform.Platform][]DetectFrameOptions{
 	platform.Node: {
 		DetectExactFrameOptions{
 			ActiveThreadOnly: true,
-			FunctionsByPackage: map[string]map[string]Category{
+			FunctionsByPackage: map[string]map[string]category.Category{
 				"node:fs": {
-					"accessSync":          FileRead,
-					"appendFileSync":      FileRead,
-					"chmodSync":           FileRead,
-					"chownSync":           FileRead,
-					"closeSync":           FileRead,
-					"copyFileSync":        FileRead,
-					"cpSync":              FileRead,
-					"existsSync":          FileRead,
-					"fchmodSync":          FileRead,
-					"fchownSync":          FileRead,
-					"fdatasyncSync":       FileRead,
-					"fstatSync":           FileRead,
-					"fsyncSync":           FileRead,
-					"ftruncateSync":       FileRead,
-					"futimesSync":         FileRead,
-					"lchmodSync":          FileRead,
-					"lchownSync":          FileRead,
-					"linkSync":            FileRead,
-					"lstatSync":           FileRead,
-					"lutimesSync":         FileRead,
-					"mkdirSync":           FileRead,
-					"mkdtempSync":         FileRead,
-					"openSync":            FileRead,
-					"opendirSync":         FileRead,
-					"readFileSync":        FileRead,
-					"readSync":            FileRead,
-					"readdirSync":         FileRead,
-					"readlinkSync":        FileRead,
-					"readvSync":           FileRead,
-					"realpathSync":        FileRead,
-					"realpathSync.native": FileRead,
-					"renameSync":          FileRead,
-					"rmSync":              FileRead,
-					"rmdirSync":           FileRead,
-					"statSync":            FileRead,
-					"symlinkSync":         FileRead,
-					"truncateSync":        FileRead,
-					"unlinkSync":          FileRead,
-					"utimesSync":          FileRead,
-					"writeFileSync":       FileRead,
-					"writeSync":           FileRead,
-					"writevSync":          FileRead,
+					"accessSync":          category.FileRead,
+					"appendFileSync":      category.FileRead,
+					"chmodSync":           category.FileRead,
+					"chownSync":           category.FileRead,
+					"closeSync":           category.FileRead,
+					"copyFileSync":        category.FileRead,
+					"cpSync":              category.FileRead,
+					"existsSync":          category.FileRead,
+					"fchmodSync":          category.FileRead,
+					"fchownSync":          category.FileRead,
+					"fdatasyncSync":       category.FileRead,
+					"fstatSync":           category.FileRead,
+					"fsyncSync":           category.FileRead,
+					"ftruncateSync":       category.FileRead,
+					"futimesSync":         category.FileRead,
+					"lchmodSync":          category.FileRead,
+					"lchownSync":          category.FileRead,
+					"linkSync":            category.FileRead,
+					"lstatSync":           category.FileRead,
+					"lutimesSync":         category.FileRead,
+					"mkdirSync":           category.FileRead,
+					"mkdtempSync":         category.FileRead,
+					"openSync":            category.FileRead,
+					"opendirSync":         category.FileRead,
+					"readFileSync":        category.FileRead,
+					"readSync":            category.FileRead,
+					"readdirSync":         category.FileRead,
+					"readlinkSync":        category.FileRead,
+					"readvSync":           category.FileRead,
+					"realpathSync":        category.FileRead,
+					"realpathSync.native": category.FileRead,
+					"renameSync":          category.FileRead,
+					"rmSync":              category.FileRead,
+					"rmdirSync":           category.FileRead,
+					"statSync":            category.FileRead,
+					"symlinkSync":         category.FileRead,
+					"truncateSync":        category.FileRead,
+					"unlinkSync":          category.FileRead,
+					"utimesSync":          category.FileRead,
+					"writeFileSync":       category.FileRead,
+					"writeSync":           category.FileRead,
+					"writevSync":          category.FileRead,
 				},
 			},
 		},
 		DetectExactFrameOptions{
 			DurationThreshold: 100 * time.Millisecond,
-			FunctionsByPackage: map[string]map[string]Category{
+			FunctionsByPackage: map[string]map[string]category.Category{
 				"": {
-					"addSourceContext":         SourceContext,
-					"addSourceContextToFrames": SourceContext,
+					"addSourceContext":         category.SourceContext,
+					"addSourceContextToFrames": category.SourceContext,
 				},
 			},
 		},
@@ -228,140 +211,140 @@ var detectFrameJobs = map[platform.Platform][]DetectFrameOptions{
 			ActiveThreadOnly:  true,
 			DurationThreshold: 16 * time.Millisecond,
 			SampleThreshold:   4,
-			FunctionsByPackage: map[string]map[string]Category{
+			FunctionsByPackage: map[string]map[string]category.Category{
 				"AppleJPEG": {
-					"applejpeg_decode_image_all": ImageDecode,
+					"applejpeg_decode_image_all": category.ImageDecode,
 				},
 				"AttributeGraph": {
-					"AG::LayoutDescriptor::make_layout(AG::swift::metadata const*, AGComparisonMode, AG::LayoutDescriptor::HeapMode)": ViewLayout,
+					"AG::LayoutDescriptor::make_layout(AG::swift::metadata const*, AGComparisonMode, AG::LayoutDescriptor::HeapMode)": category.ViewLayout,
 				},
 				"CoreData": {
-					"-[NSManagedObjectContext countForFetchRequest:error:]":                 CoreDataRead,
-					"-[NSManagedObjectContext executeFetchRequest:error:]":                  CoreDataRead,
-					"-[NSManagedObjectContext executeRequest:error:]":                       CoreDataRead,
-					"-[NSManagedObjectContext mergeChangesFromContextDidSaveNotification:]": CoreDataMerge,
-					"-[NSManagedObjectContext obtainPermanentIDsForObjects:error:]":         CoreDataWrite,
-					"-[NSManagedObjectContext performBlockAndWait:]":                        CoreDataBlock,
-					"-[NSManagedObjectContext save:]":                                       CoreDataWrite,
-					"NSManagedObjectContext.fetch<A>(NSFetchRequest<A>)":                    CoreDataRead,
+					"-[NSManagedObjectContext countForFetchRequest:error:]":                 category.CoreDataRead,
+					"-[NSManagedObjectContext executeFetchRequest:error:]":                  category.CoreDataRead,
+					"-[NSManagedObjectContext executeRequest:error:]":                       category.CoreDataRead,
+					"-[NSManagedObjectContext mergeChangesFromContextDidSaveNotification:]": category.CoreDataMerge,
+					"-[NSManagedObjectContext obtainPermanentIDsForObjects:error:]":         category.CoreDataWrite,
+					"-[NSManagedObjectContext performBlockAndWait:]":                        category.CoreDataBlock,
+					"-[NSManagedObjectContext save:]":                                       category.CoreDataWrite,
+					"NSManagedObjectContext.fetch<A>(NSFetchRequest<A>)":                    category.CoreDataRead,
 				},
 				"CoreFoundation": {
-					"CFReadStreamRead":                         FileRead,
-					"CFURLConnectionSendSynchronousRequest":    HTTP,
-					"CFURLCreateData":                          FileRead,
-					"CFURLCreateDataAndPropertiesFromResource": FileRead,
-					"CFURLWriteDataAndPropertiesToResource":    FileWrite,
-					"CFWriteStreamWrite":                       FileWrite,
+					"CFReadStreamRead":                         category.FileRead,
+					"CFURLConnectionSendSynchronousRequest":    category.HTTP,
+					"CFURLCreateData":                          category.FileRead,
+					"CFURLCreateDataAndPropertiesFromResource": category.FileRead,
+					"CFURLWriteDataAndPropertiesToResource":    category.FileWrite,
+					"CFWriteStreamWrite":                       category.FileWrite,
 				},
 				"CoreML": {
-					"+[MLModel modelWithContentsOfURL:configuration:error:]":         MLModelLoad,
-					"-[MLNeuralNetworkEngine predictionFromFeatures:options:error:]": MLModelInference,
+					"+[MLModel modelWithContentsOfURL:configuration:error:]":         category.MLModelLoad,
+					"-[MLNeuralNetworkEngine predictionFromFeatures:options:error:]": category.MLModelInference,
 				},
 				"Foundation": {
-					"+[NSJSONSerialization JSONObjectWithStream:options:error:]":                            JSONDecode,
-					"+[NSJSONSerialization writeJSONObject:toStream:options:error:]":                        JSONEncode,
-					"+[NSRegularExpression regularExpressionWithPattern:options:error:]":                    Regex,
-					"-[NSRegularExpression initWithPattern:options:error:]":                                 Regex,
-					"-[NSRegularExpression(NSMatching) enumerateMatchesInString:options:range:usingBlock:]": Regex,
-					"Regex.firstMatch(in: String)":                                                          Regex,
-					"Regex.wholeMatch(in: String)":                                                          Regex,
-					"Regex.prefixMatch(in: String)":                                                         Regex,
-					"+[NSURLConnection sendSynchronousRequest:returningResponse:error:]":                    HTTP,
-					"-[NSData(NSData) initWithContentsOfMappedFile:]":                                       FileRead,
-					"-[NSData(NSData) initWithContentsOfURL:]":                                              FileRead,
-					"-[NSData(NSData) initWithContentsOfURL:options:maxLength:error:]":                      FileRead,
-					"-[NSData(NSData) writeToFile:atomically:]":                                             FileWrite,
-					"-[NSData(NSData) writeToFile:atomically:error:]":                                       FileWrite,
-					"-[NSData(NSData) writeToFile:options:error:]":                                          FileWrite,
-					"-[NSData(NSData) writeToURL:atomically:]":                                              FileWrite,
-					"-[NSData(NSData) writeToURL:options:error:]":                                           FileWrite,
-					"-[NSFileManager contentsAtPath:]":                                                      FileRead,
-					"-[NSFileManager createFileAtPath:contents:attributes:]":                                FileWrite,
-					"-[NSISEngine performModifications:withUnsatisfiableConstraintsHandler:]":               ViewLayout,
-					"@nonobjc NSData.init(contentsOf: URL, options: NSDataReadingOptions)":                  FileRead,
-					"Data.init(contentsOf: __shared URL, options: NSDataReadingOptions)":                    FileRead,
-					"JSONDecoder.decode<A>(_: A.Type, from: Any)":                                           JSONDecode,
-					"JSONDecoder.decode<A>(_: A.Type, from: Data)":                                          JSONDecode,
-					"JSONDecoder.decode<A>(_: A.Type, jsonData: Data, logErrors: Bool)":                     JSONDecode,
-					"-[_NSJSONReader parseData:options:error:]":                                             JSONEncode,
-					"JSONEncoder.encode<A>(A)":                                                              JSONEncode,
-					"NSFileManager.contents(atURL: URL)":                                                    FileRead,
+					"+[NSJSONSerialization JSONObjectWithStream:options:error:]":                            category.JSONDecode,
+					"+[NSJSONSerialization writeJSONObject:toStream:options:error:]":                        category.JSONEncode,
+					"+[NSRegularExpression regularExpressionWithPattern:options:error:]":                    category.Regex,
+					"-[NSRegularExpression initWithPattern:options:error:]":                                 category.Regex,
+					"-[NSRegularExpression(NSMatching) enumerateMatchesInString:options:range:usingBlock:]": category.Regex,
+					"Regex.firstMatch(in: String)":                                                          category.Regex,
+					"Regex.wholeMatch(in: String)":                                                          category.Regex,
+					"Regex.prefixMatch(in: String)":                                                         category.Regex,
+					"+[NSURLConnection sendSynchronousRequest:returningResponse:error:]":                    category.HTTP,
+					"-[NSData(NSData) initWithContentsOfMappedFile:]":                                       category.FileRead,
+					"-[NSData(NSData) initWithContentsOfURL:]":                                              category.FileRead,
+					"-[NSData(NSData) initWithContentsOfURL:options:maxLength:error:]":                      category.FileRead,
+					"-[NSData(NSData) writeToFile:atomically:]":                                             category.FileWrite,
+					"-[NSData(NSData) writeToFile:atomically:error:]":                                       category.FileWrite,
+					"-[NSData(NSData) writeToFile:options:error:]":                                          category.FileWrite,
+					"-[NSData(NSData) writeToURL:atomically:]":                                              category.FileWrite,
+					"-[NSData(NSData) writeToURL:options:error:]":                                           category.FileWrite,
+					"-[NSFileManager contentsAtPath:]":                                                      category.FileRead,
+					"-[NSFileManager createFileAtPath:contents:attributes:]":                                category.FileWrite,
+					"-[NSISEngine performModifications:withUnsatisfiableConstraintsHandler:]":               category.ViewLayout,
+					"@nonobjc NSData.init(contentsOf: URL, options: NSDataReadingOptions)":                  category.FileRead,
+					"Data.init(contentsOf: __shared URL, options: NSDataReadingOptions)":                    category.FileRead,
+					"JSONDecoder.decode<A>(_: A.Type, from: Any)":                                           category.JSONDecode,
+					"JSONDecoder.decode<A>(_: A.Type, from: Data)":                                          category.JSONDecode,
+					"JSONDecoder.decode<A>(_: A.Type, jsonData: Data, logErrors: Bool)":                     category.JSONDecode,
+					"-[_NSJSONReader parseData:options:error:]":                                             category.JSONEncode,
+					"JSONEncoder.encode<A>(A)":                                                              category.JSONEncode,
+					"NSFileManager.contents(atURL: URL)":                                                    category.FileRead,
 				},
 				"ImageIO": {
-					"DecodeImageData":   ImageDecode,
-					"DecodeImageStream": ImageDecode,
-					"GIFReadPlugin::DoDecodeImageData(IIOImageReadSession*, GlobalGIFInfo*, ReadPluginData const&, GIFPluginData const&, unsigned char*, unsigned long, std::__1::shared_ptr<GIFBufferInfo>, long*)": ImageDecode,
-					"IIOImageProviderInfo::CopyImageBlockSetWithOptions(void*, CGImageProvider*, CGRect, CGSize, __CFDictionary const*)":                                                                             ImageDecode,
-					"LZWDecode":  ImageDecode,
-					"NeXTDecode": ImageDecode,
-					"PNGReadPlugin::DecodeFrameStandard(IIOImageReadSession*, ReadPluginData const&, PNGPluginData const&, IIODecodeFrameParams&)": ImageDecode,
-					"VP8Decode":        ImageDecode,
-					"VP8DecodeMB":      ImageDecode,
-					"WebPDecode":       ImageDecode,
-					"jpeg_huff_decode": ImageDecode,
+					"DecodeImageData":   category.ImageDecode,
+					"DecodeImageStream": category.ImageDecode,
+					"GIFReadPlugin::DoDecodeImageData(IIOImageReadSession*, GlobalGIFInfo*, ReadPluginData const&, GIFPluginData const&, unsigned char*, unsigned long, std::__1::shared_ptr<GIFBufferInfo>, long*)": category.ImageDecode,
+					"IIOImageProviderInfo::CopyImageBlockSetWithOptions(void*, CGImageProvider*, CGRect, CGSize, __CFDictionary const*)":                                                                             category.ImageDecode,
+					"LZWDecode":  category.ImageDecode,
+					"NeXTDecode": category.ImageDecode,
+					"PNGReadPlugin::DecodeFrameStandard(IIOImageReadSession*, ReadPluginData const&, PNGPluginData const&, IIODecodeFrameParams&)": category.ImageDecode,
+					"VP8Decode":        category.ImageDecode,
+					"VP8DecodeMB":      category.ImageDecode,
+					"WebPDecode":       category.ImageDecode,
+					"jpeg_huff_decode": category.ImageDecode,
 				},
 				"libcompression.dylib": {
-					"BrotliDecoderDecompress": Compression,
-					"brotli_encode_buffer":    Compression,
-					"lz4_decode":              Compression,
-					"lz4_decode_asm":          Compression,
-					"lzfseDecode":             Compression,
-					"lzfseEncode":             Compression,
-					"lzfseStreamDecode":       Compression,
-					"lzfseStreamEncode":       Compression,
-					"lzvnDecode":              Compression,
-					"lzvnEncode":              Compression,
-					"lzvnStreamDecode":        Compression,
-					"lzvnStreamEncode":        Compression,
-					"zlibDecodeBuffer":        Compression,
-					"zlib_decode_buffer":      Compression,
-					"zlib_encode_buffer":      Compression,
+					"BrotliDecoderDecompress": category.Compression,
+					"brotli_encode_buffer":    category.Compression,
+					"lz4_decode":              category.Compression,
+					"lz4_decode_asm":          category.Compression,
+					"lzfseDecode":             category.Compression,
+					"lzfseEncode":             category.Compression,
+					"lzfseStreamDecode":       category.Compression,
+					"lzfseStreamEncode":       category.Compression,
+					"lzvnDecode":              category.Compression,
+					"lzvnEncode":              category.Compression,
+					"lzvnStreamDecode":        category.Compression,
+					"lzvnStreamEncode":        category.Compression,
+					"zlibDecodeBuffer":        category.Compression,
+					"zlib_decode_buffer":      category.Compression,
+					"zlib_encode_buffer":      category.Compression,
 				},
 				"libsqlite3.dylib": {
-					"sqlite3_blob_read":      SQL,
-					"sqlite3_column_blob":    SQL,
-					"sqlite3_column_bytes":   SQL,
-					"sqlite3_column_double":  SQL,
-					"sqlite3_column_int":     SQL,
-					"sqlite3_column_int64":   SQL,
-					"sqlite3_column_text":    SQL,
-					"sqlite3_column_text16":  SQL,
-					"sqlite3_column_value":   SQL,
-					"sqlite3_step":           SQL,
-					"sqlite3_value_blob":     SQL,
-					"sqlite3_value_double":   SQL,
-					"sqlite3_value_int":      SQL,
-					"sqlite3_value_int64":    SQL,
-					"sqlite3_value_pointer":  SQL,
-					"sqlite3_value_text":     SQL,
-					"sqlite3_value_text16":   SQL,
-					"sqlite3_value_text16be": SQL,
-					"sqlite3_value_text16le": SQL,
+					"sqlite3_blob_read":      category.SQL,
+					"sqlite3_column_blob":    category.SQL,
+					"sqlite3_column_bytes":   category.SQL,
+					"sqlite3_column_double":  category.SQL,
+					"sqlite3_column_int":     category.SQL,
+					"sqlite3_column_int64":   category.SQL,
+					"sqlite3_column_text":    category.SQL,
+					"sqlite3_column_text16":  category.SQL,
+					"sqlite3_column_value":   category.SQL,
+					"sqlite3_step":           category.SQL,
+					"sqlite3_value_blob":     category.SQL,
+					"sqlite3_value_double":   category.SQL,
+					"sqlite3_value_int":      category.SQL,
+					"sqlite3_value_int64":    category.SQL,
+					"sqlite3_value_pointer":  category.SQL,
+					"sqlite3_value_text":     category.SQL,
+					"sqlite3_value_text16":   category.SQL,
+					"sqlite3_value_text16be": category.SQL,
+					"sqlite3_value_text16le": category.SQL,
 				},
 				"libswiftCoreData.dylib": {
-					"NSManagedObjectContext.count<A>(for: NSFetchRequest<A>)":                                      CoreDataRead,
-					"NSManagedObjectContext.fetch<A>(NSFetchRequest<A>)":                                           CoreDataRead,
-					"NSManagedObjectContext.perform<A>(schedule: NSManagedObjectContext.ScheduledTaskType, _: ())": CoreDataBlock,
+					"NSManagedObjectContext.count<A>(for: NSFetchRequest<A>)":                                      category.CoreDataRead,
+					"NSManagedObjectContext.fetch<A>(NSFetchRequest<A>)":                                           category.CoreDataRead,
+					"NSManagedObjectContext.perform<A>(schedule: NSManagedObjectContext.ScheduledTaskType, _: ())": category.CoreDataBlock,
 				},
 				"libswiftFoundation.dylib": {
-					"__JSONDecoder.decode<A>(A.Type)": JSONDecode,
-					"__JSONEncoder.encode<A>(A)":      JSONEncode,
+					"__JSONDecoder.decode<A>(A.Type)": category.JSONDecode,
+					"__JSONEncoder.encode<A>(A)":      category.JSONEncode,
 				},
 				"libsystem_c.dylib": {
-					"__fread": FileRead,
-					"fread":   FileRead,
+					"__fread": category.FileRead,
+					"fread":   category.FileRead,
 				},
 				"libxpc.dylib": {
-					"xpc_connection_send_message_with_reply_sync": XPC,
+					"xpc_connection_send_message_with_reply_sync": category.XPC,
 				},
 				"SwiftUI": {
-					"UnaryLayoutEngine.sizeThatFits(_ProposedSize)":                      ViewLayout,
-					"ViewRendererHost.render(interval: Double, updateDisplayList: Bool)": ViewRender,
-					"ViewRendererHost.updateViewGraph<A>(body: (ViewGraph))":             ViewUpdate,
+					"UnaryLayoutEngine.sizeThatFits(_ProposedSize)":                      category.ViewLayout,
+					"ViewRendererHost.render(interval: Double, updateDisplayList: Bool)": category.ViewRender,
+					"ViewRendererHost.updateViewGraph<A>(body: (ViewGraph))":             category.ViewUpdate,
 				},
 				"UIKit": {
-					"-[_UIPathLazyImageAsset imageWithConfiguration:]": ImageDecode,
-					"-[UINib instantiateWithOwner:options:]":           ViewInflation,
+					"-[_UIPathLazyImageAsset imageWithConfiguration:]": category.ImageDecode,
+					"-[UINib instantiateWithOwner:options:]":           category.ViewInflation,
 				},
 			},
 		},
@@ -370,96 +353,96 @@ var detectFrameJobs = map[platform.Platform][]DetectFrameOptions{
 		DetectAndroidFrameOptions{
 			ActiveThreadOnly:  true,
 			DurationThreshold: 40 * time.Millisecond,
-			FunctionsByPackage: map[string]map[string]Category{
+			FunctionsByPackage: map[string]map[string]category.Category{
 				"com.google.gson": {
-					"com.google.gson.Gson.fromJson":   JSONDecode,
-					"com.google.gson.Gson.toJson":     JSONEncode,
-					"com.google.gson.Gson.toJsonTree": JSONEncode,
+					"com.google.gson.Gson.fromJson":   category.JSONDecode,
+					"com.google.gson.Gson.toJson":     category.JSONEncode,
+					"com.google.gson.Gson.toJsonTree": category.JSONEncode,
 				},
 				"org.json": {
-					"org.json.JSONArray.get":        JSONDecode,
-					"org.json.JSONArray.opt":        JSONDecode,
-					"org.json.JSONArray.writeTo":    JSONEncode,
-					"org.json.JSONObject.checkName": JSONDecode,
-					"org.json.JSONObject.get":       JSONDecode,
-					"org.json.JSONObject.opt":       JSONDecode,
-					"org.json.JSONObject.put":       JSONEncode,
-					"org.json.JSONObject.putOpt":    JSONEncode,
-					"org.json.JSONObject.remove":    JSONEncode,
-					"org.json.JSONObject.writeTo":   JSONEncode,
+					"org.json.JSONArray.get":        category.JSONDecode,
+					"org.json.JSONArray.opt":        category.JSONDecode,
+					"org.json.JSONArray.writeTo":    category.JSONEncode,
+					"org.json.JSONObject.checkName": category.JSONDecode,
+					"org.json.JSONObject.get":       category.JSONDecode,
+					"org.json.JSONObject.opt":       category.JSONDecode,
+					"org.json.JSONObject.put":       category.JSONEncode,
+					"org.json.JSONObject.putOpt":    category.JSONEncode,
+					"org.json.JSONObject.remove":    category.JSONEncode,
+					"org.json.JSONObject.writeTo":   category.JSONEncode,
 				},
 				"android.content.res": {
-					"android.content.res.AssetManager.open":   FileRead,
-					"android.content.res.AssetManager.openFd": FileRead,
+					"android.content.res.AssetManager.open":   category.FileRead,
+					"android.content.res.AssetManager.openFd": category.FileRead,
 				},
 				"java.io": {
-					"java.io.File.canExecute":             FileRead,
-					"java.io.File.canRead":                FileRead,
-					"java.io.File.canWrite":               FileRead,
-					"java.io.File.createNewFile":          FileWrite,
-					"java.io.File.createTempFile":         FileWrite,
-					"java.io.File.delete":                 FileWrite,
-					"java.io.File.exists":                 FileRead,
-					"java.io.File.length":                 FileRead,
-					"java.io.File.mkdir":                  FileWrite,
-					"java.io.File.mkdirs":                 FileWrite,
-					"java.io.File.renameTo":               FileWrite,
-					"java.io.FileInputStream.open":        FileRead,
-					"java.io.FileInputStream.read":        FileRead,
-					"java.io.FileOutputStream.open":       FileRead,
-					"java.io.FileOutputStream.write":      FileWrite,
-					"java.io.RandomAccessFile.readBytes":  FileRead,
-					"java.io.RandomAccessFile.writeBytes": FileWrite,
+					"java.io.File.canExecute":             category.FileRead,
+					"java.io.File.canRead":                category.FileRead,
+					"java.io.File.canWrite":               category.FileRead,
+					"java.io.File.createNewFile":          category.FileWrite,
+					"java.io.File.createTempFile":         category.FileWrite,
+					"java.io.File.delete":                 category.FileWrite,
+					"java.io.File.exists":                 category.FileRead,
+					"java.io.File.length":                 category.FileRead,
+					"java.io.File.mkdir":                  category.FileWrite,
+					"java.io.File.mkdirs":                 category.FileWrite,
+					"java.io.File.renameTo":               category.FileWrite,
+					"java.io.FileInputStream.open":        category.FileRead,
+					"java.io.FileInputStream.read":        category.FileRead,
+					"java.io.FileOutputStream.open":       category.FileRead,
+					"java.io.FileOutputStream.write":      category.FileWrite,
+					"java.io.RandomAccessFile.readBytes":  category.FileRead,
+					"java.io.RandomAccessFile.writeBytes": category.FileWrite,
 				},
 				"okio": {
-					"okio.Buffer.read":     FileRead,
-					"okio.Buffer.readByte": FileRead,
-					"okio.Buffer.write":    FileWrite,
-					"okio.Buffer.writeAll": FileWrite,
+					"okio.Buffer.read":     category.FileRead,
+					"okio.Buffer.readByte": category.FileRead,
+					"okio.Buffer.write":    category.FileWrite,
+					"okio.Buffer.writeAll": category.FileWrite,
 				},
 				"android.graphics": {
-					"android.graphics.BitmapFactory.decodeByteArray":      ImageDecode,
-					"android.graphics.BitmapFactory.decodeFile":           ImageDecode,
-					"android.graphics.BitmapFactory.decodeFileDescriptor": ImageDecode,
-					"android.graphics.BitmapFactory.decodeStream":         ImageDecode,
+					"android.graphics.BitmapFactory.decodeByteArray":      category.ImageDecode,
+					"android.graphics.BitmapFactory.decodeFile":           category.ImageDecode,
+					"android.graphics.BitmapFactory.decodeFileDescriptor": category.ImageDecode,
+					"android.graphics.BitmapFactory.decodeStream":         category.ImageDecode,
 				},
 				"android.database.sqlite": {
-					"android.database.sqlite.SQLiteDatabase.insertWithOnConflict": SQL,
-					"android.database.sqlite.SQLiteDatabase.open":                 SQL,
-					"android.database.sqlite.SQLiteDatabase.query":                SQL,
-					"android.database.sqlite.SQLiteDatabase.rawQueryWithFactory":  SQL,
-					"android.database.sqlite.SQLiteStatement.execute":             SQL,
-					"android.database.sqlite.SQLiteStatement.executeInsert":       SQL,
-					"android.database.sqlite.SQLiteStatement.executeUpdateDelete": SQL,
-					"android.database.sqlite.SQLiteStatement.simpleQueryForLong":  SQL,
+					"android.database.sqlite.SQLiteDatabase.insertWithOnConflict": category.SQL,
+					"android.database.sqlite.SQLiteDatabase.open":                 category.SQL,
+					"android.database.sqlite.SQLiteDatabase.query":                category.SQL,
+					"android.database.sqlite.SQLiteDatabase.rawQueryWithFactory":  category.SQL,
+					"android.database.sqlite.SQLiteStatement.execute":             category.SQL,
+					"android.database.sqlite.SQLiteStatement.executeInsert":       category.SQL,
+					"android.database.sqlite.SQLiteStatement.executeUpdateDelete": category.SQL,
+					"android.database.sqlite.SQLiteStatement.simpleQueryForLong":  category.SQL,
 				},
 				"androidx.room": {
-					"androidx.room.RoomDatabase.query": SQL,
+					"androidx.room.RoomDatabase.query": category.SQL,
 				},
 				"java.util.zip": {
-					"java.util.zip.Deflater.deflate":           Compression,
-					"java.util.zip.Deflater.deflateBytes":      Compression,
-					"java.util.zip.DeflaterOutputStream.write": Compression,
-					"java.util.zip.GZIPInputStream.read":       Compression,
-					"java.util.zip.GZIPOutputStream.write":     Compression,
-					"java.util.zip.Inflater.inflate":           Compression,
-					"java.util.zip.Inflater.inflateBytes":      Compression,
+					"java.util.zip.Deflater.deflate":           category.Compression,
+					"java.util.zip.Deflater.deflateBytes":      category.Compression,
+					"java.util.zip.DeflaterOutputStream.write": category.Compression,
+					"java.util.zip.GZIPInputStream.read":       category.Compression,
+					"java.util.zip.GZIPOutputStream.write":     category.Compression,
+					"java.util.zip.Inflater.inflate":           category.Compression,
+					"java.util.zip.Inflater.inflateBytes":      category.Compression,
 				},
 				"java.util": {
-					"java.util.Base64$Decoder.decode":  Base64Decode,
-					"java.util.Base64$Decoder.decode0": Base64Decode,
+					"java.util.Base64$Decoder.decode":  category.Base64Decode,
+					"java.util.Base64$Decoder.decode0": category.Base64Decode,
 				},
 				"java.util.regex": {
-					"java.util.regex.Matcher.matches":   Regex,
-					"java.util.regex.Matcher.find":      Regex,
-					"java.util.regex.Matcher.lookingAt": Regex,
+					"java.util.regex.Matcher.matches":   category.Regex,
+					"java.util.regex.Matcher.find":      category.Regex,
+					"java.util.regex.Matcher.lookingAt": category.Regex,
 				},
 				"kotlinx.coroutines": {
-					"kotlinx.coroutines.AwaitAll.await":                 ThreadWait,
-					"kotlinx.coroutines.AwaitKt.awaitAll":               ThreadWait,
-					"kotlinx.coroutines.BlockingCoroutine.joinBlocking": ThreadWait,
-					"kotlinx.coroutines.JobSupport.join":                ThreadWait,
-					"kotlinx.coroutines.JobSupport.joinSuspend":         ThreadWait,
+					"kotlinx.coroutines.AwaitAll.await":                 category.ThreadWait,
+					"kotlinx.coroutines.AwaitKt.awaitAll":               category.ThreadWait,
+					"kotlinx.coroutines.BlockingCoroutine.joinBlocking": category.ThreadWait,
+					"kotlinx.coroutines.JobSupport.join":                category.ThreadWait,
+					"kotlinx.coroutines.JobSupport.joinSuspend":         category.ThreadWait,
 				},
 			},
 		},
@@ -471,6 +454,7 @@ func detectFrame(
 	p profile.Profile,
 	callTreesPerThreadID map[uint64][]*nodetree.Node,
 	options DetectFrameOptions,
+	overrides detectionOverrides,
 	occurrences *[]*Occurrence,
 ) {
 	// List nodes matching criteria
@@ -485,12 +469,12 @@ func detectFrame(
 			return
 		}
 		for _, root := range callTrees {
-			detectFrameInCallTree(root, options, nodes)
+			detectFrameInCallTree(root, options, overrides, nodes)
 		}
 	} else {
 		for _, callTrees := range callTreesPerThreadID {
 			for _, root := range callTrees {
-				detectFrameInCallTree(root, options, nodes)
+				detectFrameInCallTree(root, options, overrides, nodes)
 			}
 		}
 	}
@@ -504,15 +488,17 @@ func detectFrame(
 func detectFrameInCallTree(
 	n *nodetree.Node,
 	options DetectFrameOptions,
+	overrides detectionOverrides,
 	nodes map[nodeKey]nodeInfo,
 ) {
 	st := make([]frame.Frame, 0, profile.MaxStackDepth)
-	detectFrameInNode(n, options, nodes, &st)
+	detectFrameInNode(n, options, overrides, nodes, &st)
 }
 
 func detectFrameInNode(
 	n *nodetree.Node,
 	options DetectFrameOptions,
+	overrides detectionOverrides,
 	nodes map[nodeKey]nodeInfo,
 	st *[]frame.Frame,
 ) *nodeInfo {
@@ -521,11 +507,11 @@ func detectFrameInNode(
 		*st = (*st)[:len(*st)-1]
 	}()
 	for _, c := range n.Children {
-		if ni := detectFrameInNode(c, options, nodes, st); ni != nil {
+		if ni := detectFrameInNode(c, options, overrides, nodes, st); ni != nil {
 			return ni
 		}
 	}
-	ni := options.checkNode(n)
+	ni := options.checkNode(n, overrides)
 	if ni != nil {
 		nk := nodeKey{Package: ni.Node.Package, Function: ni.Node.Name}
 		if _, exists := nodes[nk]; !exists {
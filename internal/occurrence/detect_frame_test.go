@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/getsentry/vroom/internal/category"
 	"github.com/getsentry/vroom/internal/frame"
 	"github.com/getsentry/vroom/internal/nodetree"
 	"github.com/getsentry/vroom/internal/testutil"
@@ -19,9 +20,9 @@ func TestDetectFrameInCallTree(t *testing.T) {
 		{
 			job: DetectExactFrameOptions{
 				DurationThreshold: 16 * time.Millisecond,
-				FunctionsByPackage: map[string]map[string]Category{
+				FunctionsByPackage: map[string]map[string]category.Category{
 					"CoreFoundation": {
-						"CFReadStreamRead": FileRead,
+						"CFReadStreamRead": category.FileRead,
 					},
 				},
 			},
@@ -163,7 +164,7 @@ func TestDetectFrameInCallTree(t *testing.T) {
 					Package:  "CoreFoundation",
 					Function: "CFReadStreamRead",
 				}: {
-					Category: FileRead,
+					Category: category.FileRead,
 					Node: nodetree.Node{
 						DurationNS:    uint64(20 * time.Millisecond),
 						EndNS:         uint64(20 * time.Millisecond),
@@ -218,12 +219,12 @@ func TestDetectFrameInCallTree(t *testing.T) {
 		{
 			job: DetectExactFrameOptions{
 				DurationThreshold: 16 * time.Millisecond,
-				FunctionsByPackage: map[string]map[string]Category{
+				FunctionsByPackage: map[string]map[string]category.Category{
 					"CoreFoundation": {
-						"CFReadStreamRead": FileRead,
+						"CFReadStreamRead": category.FileRead,
 					},
 					"vroom": {
-						"SuperShortFunction": FileRead,
+						"SuperShortFunction": category.FileRead,
 					},
 				},
 			},
@@ -309,10 +310,10 @@ func TestDetectFrameInCallTree(t *testing.T) {
 			job: DetectExactFrameOptions{
 				DurationThreshold: 16 * time.Millisecond,
 				SampleThreshold:   4,
-				FunctionsByPackage: map[string]map[string]Category{
+				FunctionsByPackage: map[string]map[string]category.Category{
 					"vroom": {
-						"FunctionWithOneSample":   FileRead,
-						"FunctionWithManySamples": FileRead,
+						"FunctionWithOneSample":   category.FileRead,
+						"FunctionWithManySamples": category.FileRead,
 					},
 				},
 			},
@@ -436,7 +437,7 @@ func TestDetectFrameInCallTree(t *testing.T) {
 					Package:  "vroom",
 					Function: "FunctionWithManySamples",
 				}: {
-					Category: FileRead,
+					Category: category.FileRead,
 					Node: nodetree.Node{
 						DurationNS:    uint64(20 * time.Millisecond),
 						EndNS:         uint64(20 * time.Millisecond),
@@ -498,10 +499,10 @@ func TestDetectFrameInCallTree(t *testing.T) {
 		{
 			job: DetectExactFrameOptions{
 				DurationThreshold: 16 * time.Millisecond,
-				FunctionsByPackage: map[string]map[string]Category{
+				FunctionsByPackage: map[string]map[string]category.Category{
 					"CoreFoundation": {
-						"LeafFunction":   FileRead,
-						"RandomFunction": FileRead,
+						"LeafFunction":   category.FileRead,
+						"RandomFunction": category.FileRead,
 					},
 				},
 			},
@@ -586,7 +587,7 @@ func TestDetectFrameInCallTree(t *testing.T) {
 					Package:  "CoreFoundation",
 					Function: "LeafFunction",
 				}: {
-					Category: FileRead,
+					Category: category.FileRead,
 					Node: nodetree.Node{
 						DurationNS:    uint64(20 * time.Millisecond),
 						EndNS:         uint64(20 * time.Millisecond),
@@ -640,9 +641,9 @@ func TestDetectFrameInCallTree(t *testing.T) {
 		{
 			job: DetectExactFrameOptions{
 				DurationThreshold: 16 * time.Millisecond,
-				FunctionsByPackage: map[string]map[string]Category{
+				FunctionsByPackage: map[string]map[string]category.Category{
 					"CoreFoundation": {
-						"RandomFunction": FileRead,
+						"RandomFunction": category.FileRead,
 					},
 				},
 			},
@@ -705,7 +706,7 @@ func TestDetectFrameInCallTree(t *testing.T) {
 					Package:  "CoreFoundation",
 					Function: "RandomFunction",
 				}: {
-					Category: FileRead,
+					Category: category.FileRead,
 					Node: nodetree.Node{
 						DurationNS:    uint64(30 * time.Millisecond),
 						EndNS:         uint64(30 * time.Millisecond),
@@ -738,9 +739,9 @@ func TestDetectFrameInCallTree(t *testing.T) {
 		{
 			job: DetectAndroidFrameOptions{
 				DurationThreshold: 16 * time.Millisecond,
-				FunctionsByPackage: map[string]map[string]Category{
+				FunctionsByPackage: map[string]map[string]category.Category{
 					"android.graphics": {
-						"android.graphics.BitmapFactory.decodeStream": ImageDecode,
+						"android.graphics.BitmapFactory.decodeStream": category.ImageDecode,
 					},
 				},
 			},
@@ -768,7 +769,7 @@ func TestDetectFrameInCallTree(t *testing.T) {
 					Package:  "android.graphics",
 					Function: "android.graphics.BitmapFactory.decodeStream(java.io.InputStream, android.graphics.Rect, android.graphics.BitmapFactory$Options): android.graphics.Bitmap",
 				}: {
-					Category: ImageDecode,
+					Category: category.ImageDecode,
 					Node: nodetree.Node{
 						DurationNS:    uint64(30 * time.Millisecond),
 						EndNS:         uint64(30 * time.Millisecond),
@@ -803,7 +804,7 @@ func TestDetectFrameInCallTree(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			nodes := make(map[nodeKey]nodeInfo)
-			detectFrameInCallTree(tt.node, tt.job, nodes)
+			detectFrameInCallTree(tt.node, tt.job, detectionOverrides{}, nodes)
 			if diff := testutil.Diff(nodes, tt.want); diff != "" {
 				t.Fatalf("Result mismatch: got - want +\n%s", diff)
 			}
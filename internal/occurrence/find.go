@@ -3,15 +3,24 @@ package occurrence
 import (
 	"github.com/getsentry/vroom/internal/nodetree"
 	"github.com/getsentry/vroom/internal/profile"
+	"github.com/getsentry/vroom/internal/utils"
 )
 
-func Find(p profile.Profile, callTrees map[uint64][]*nodetree.Node) []*Occurrence {
+// Find runs all the detectors on the profile, applying the project overrides
+// passed in options.
+func Find(
+	p profile.Profile,
+	callTrees map[uint64][]*nodetree.Node,
+	options utils.DetectionOptions,
+) []*Occurrence {
 	var occurrences []*Occurrence
+	overrides := newDetectionOverrides(options)
 	if jobs, exists := detectFrameJobs[p.Platform()]; exists {
 		for _, metadata := range jobs {
-			detectFrame(p, callTrees, metadata, &occurrences)
+			detectFrame(p, callTrees, metadata, overrides, &occurrences)
 		}
 	}
-	findFrameDropCause(p, callTrees, &occurrences)
+	findFrameDropCause(p, callTrees, overrides, &occurrences)
+	findAppStartCause(p, callTrees, overrides, &occurrences)
 	return occurrences
 }
@@ -4,6 +4,7 @@ import (
 	"math"
 	"time"
 
+	"github.com/getsentry/vroom/internal/category"
 	"github.com/getsentry/vroom/internal/frame"
 	"github.com/getsentry/vroom/internal/nodetree"
 	"github.com/getsentry/vroom/internal/profile"
@@ -51,8 +52,6 @@ func (s *frozenFrameStats) IsNodeStackValid(ns *nodeStack) bool {
 }
 
 const (
-	FrameDrop Category = "frame_drop"
-
 	marginPercent                    float64 = 0.05
 	minFrameDurationPercent          float64 = 0.5
 	startLimitPercent                float64 = 0.2
@@ -62,8 +61,12 @@ const (
 func findFrameDropCause(
 	p profile.Profile,
 	callTreesPerThreadID map[uint64][]*nodetree.Node,
+	overrides detectionOverrides,
 	occurrences *[]*Occurrence,
 ) {
+	if overrides.isDisabled(category.FrameDrop) {
+		return
+	}
 	frameDrops, exists := p.Measurements()["frozen_frame_renders"]
 	if !exists {
 		return
@@ -82,7 +85,7 @@ func findFrameDropCause(
 				&st,
 				0,
 			)
-			if cause == nil {
+			if cause == nil || overrides.isMuted(cause.n.Package, cause.n.Name) {
 				continue
 			}
 			// We found a potential stacktrace responsible for this frozen frame
@@ -102,7 +105,7 @@ func findFrameDropCause(
 			*occurrences = append(
 				*occurrences,
 				NewOccurrence(p, nodeInfo{
-					Category:   FrameDrop,
+					Category:   category.FrameDrop,
 					Node:       *cause.n,
 					StackTrace: stackTrace,
 				}),
@@ -7,6 +7,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
+	"github.com/getsentry/vroom/internal/category"
 	"github.com/getsentry/vroom/internal/frame"
 	"github.com/getsentry/vroom/internal/measurements"
 	"github.com/getsentry/vroom/internal/nodetree"
@@ -161,11 +162,11 @@ func TestFindFrameDrop(t *testing.T) {
 						{Name: "Suspect function", Value: "child2", Important: true},
 						{Name: "Package", Value: "package"},
 					},
-					IssueTitle:  issueTitles[FrameDrop].IssueTitle,
+					IssueTitle:  issueTitles[category.FrameDrop].IssueTitle,
 					Level:       "info",
 					PayloadType: "occurrence",
 					Subtitle:    "child2",
-					Type:        issueTitles[FrameDrop].Type,
+					Type:        issueTitles[category.FrameDrop].Type,
 				},
 			},
 		},
@@ -384,11 +385,11 @@ func TestFindFrameDrop(t *testing.T) {
 						},
 						{Name: "Package", Value: "package"},
 					},
-					IssueTitle:  issueTitles[FrameDrop].IssueTitle,
+					IssueTitle:  issueTitles[category.FrameDrop].IssueTitle,
 					Level:       "info",
 					PayloadType: "occurrence",
 					Subtitle:    "child2-1-1",
-					Type:        issueTitles[FrameDrop].Type,
+					Type:        issueTitles[category.FrameDrop].Type,
 				},
 			},
 		},
@@ -586,11 +587,11 @@ func TestFindFrameDrop(t *testing.T) {
 						},
 						{Name: "Package", Value: "package"},
 					},
-					IssueTitle:  issueTitles[FrameDrop].IssueTitle,
+					IssueTitle:  issueTitles[category.FrameDrop].IssueTitle,
 					Level:       "info",
 					PayloadType: "occurrence",
 					Subtitle:    "child2-1",
-					Type:        issueTitles[FrameDrop].Type,
+					Type:        issueTitles[category.FrameDrop].Type,
 				},
 			},
 		},
@@ -755,11 +756,11 @@ func TestFindFrameDrop(t *testing.T) {
 						},
 						{Name: "Package", Value: "package"},
 					},
-					IssueTitle:  issueTitles[FrameDrop].IssueTitle,
+					IssueTitle:  issueTitles[category.FrameDrop].IssueTitle,
 					Level:       "info",
 					PayloadType: "occurrence",
 					Subtitle:    "child2-1",
-					Type:        issueTitles[FrameDrop].Type,
+					Type:        issueTitles[category.FrameDrop].Type,
 				},
 			},
 		},
@@ -774,7 +775,7 @@ func TestFindFrameDrop(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			var occurrences []*Occurrence
-			findFrameDropCause(tt.profile, tt.callTrees, &occurrences)
+			findFrameDropCause(tt.profile, tt.callTrees, detectionOverrides{}, &occurrences)
 			if diff := testutil.Diff(occurrences, tt.want, options); diff != "" {
 				t.Fatalf("Result mismatch: got - want +\n%s", diff)
 			}
@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/getsentry/vroom/internal/android"
+	"github.com/getsentry/vroom/internal/category"
 	"github.com/getsentry/vroom/internal/debugmeta"
 	"github.com/getsentry/vroom/internal/frame"
 	"github.com/getsentry/vroom/internal/platform"
@@ -64,7 +65,7 @@ type (
 		Type            Type                   `json:"type"`
 
 		// Only use for stats.
-		category    Category
+		category    category.Category
 		durationNS  uint64
 		sampleCount int
 	}
@@ -77,8 +78,6 @@ type (
 		IssueTitle IssueTitle
 		Type       Type
 	}
-
-	Category string
 )
 
 const (
@@ -92,13 +91,15 @@ const (
 	FrameDropType          Type = 2009
 	FrameRegressionExpType Type = 2010
 	FrameRegressionType    Type = 2011
+	AppStartType           Type = 2012
 
-	EvidenceNameDuration       EvidenceName = "Duration"
-	EvidenceNameFunction       EvidenceName = "Suspect function"
-	EvidenceNamePackage        EvidenceName = "Package"
-	EvidenceFullyQualifiedName EvidenceName = "Fully qualified name"
-	EvidenceBreakpoint         EvidenceName = "Breakpoint"
-	EvidenceRegression         EvidenceName = "Regression"
+	EvidenceNameDuration         EvidenceName = "Duration"
+	EvidenceNameFunction         EvidenceName = "Suspect function"
+	EvidenceNamePackage          EvidenceName = "Package"
+	EvidenceFullyQualifiedName   EvidenceName = "Fully qualified name"
+	EvidenceBreakpoint           EvidenceName = "Breakpoint"
+	EvidenceRegression           EvidenceName = "Regression"
+	EvidenceNameAppStartDuration EvidenceName = "App start duration"
 
 	ContextTrace Context = "trace"
 
@@ -107,34 +108,35 @@ const (
 	OccurrencePayload PayloadType = "occurrence"
 )
 
-var issueTitles = map[Category]CategoryMetadata{
-	Base64Decode:     {IssueTitle: "Base64 Decode on Main Thread"},
-	Base64Encode:     {IssueTitle: "Base64 Encode on Main Thread"},
-	Compression:      {IssueTitle: "Compression on Main Thread"},
-	CoreDataBlock:    {IssueTitle: "Object Context operation on Main Thread", Type: CoreDataType},
-	CoreDataMerge:    {IssueTitle: "Object Context operation on Main Thread", Type: CoreDataType},
-	CoreDataRead:     {IssueTitle: "Object Context operation on Main Thread", Type: CoreDataType},
-	CoreDataWrite:    {IssueTitle: "Object Context operation on Main Thread", Type: CoreDataType},
-	Decompression:    {IssueTitle: "Decompression on Main Thread"},
-	FileRead:         {IssueTitle: "File I/O on Main Thread"},
-	FileWrite:        {IssueTitle: "File I/O on Main Thread"},
-	FrameDrop:        {IssueTitle: "Frame Drop", Type: FrameDropType},
-	HTTP:             {IssueTitle: "Network I/O on Main Thread"},
-	ImageDecode:      {IssueTitle: "Image Decoding on Main Thread", Type: ImageDecodeType},
-	ImageEncode:      {IssueTitle: "Image Encoding on Main Thread"},
-	JSONDecode:       {IssueTitle: "JSON Decoding on Main Thread", Type: JSONDecodeType},
-	JSONEncode:       {IssueTitle: "JSON Encoding on Main Thread"},
-	MLModelInference: {IssueTitle: "Machine Learning inference on Main Thread"},
-	MLModelLoad:      {IssueTitle: "Machine Learning model load on Main Thread"},
-	Regex:            {IssueTitle: "Regex on Main Thread", Type: RegexType},
-	SQL:              {IssueTitle: "SQL operation on Main Thread"},
-	SourceContext:    {IssueTitle: "Adding Source Context is slow"},
-	ThreadWait:       {IssueTitle: "Thread Wait on Main Thread"},
-	ViewInflation:    {IssueTitle: "SwiftUI View Inflation is slow"},
-	ViewLayout:       {IssueTitle: "SwiftUI View Layout is slow", Type: ViewType},
-	ViewRender:       {IssueTitle: "SwiftUI View Render is slow", Type: ViewType},
-	ViewUpdate:       {IssueTitle: "SwiftUI View Update is slow", Type: ViewType},
-	XPC:              {IssueTitle: "XPC operation on Main Thread"},
+var issueTitles = map[category.Category]CategoryMetadata{
+	category.AppStart:         {IssueTitle: "Slow App Start", Type: AppStartType},
+	category.Base64Decode:     {IssueTitle: "Base64 Decode on Main Thread"},
+	category.Base64Encode:     {IssueTitle: "Base64 Encode on Main Thread"},
+	category.Compression:      {IssueTitle: "Compression on Main Thread"},
+	category.CoreDataBlock:    {IssueTitle: "Object Context operation on Main Thread", Type: CoreDataType},
+	category.CoreDataMerge:    {IssueTitle: "Object Context operation on Main Thread", Type: CoreDataType},
+	category.CoreDataRead:     {IssueTitle: "Object Context operation on Main Thread", Type: CoreDataType},
+	category.CoreDataWrite:    {IssueTitle: "Object Context operation on Main Thread", Type: CoreDataType},
+	category.Decompression:    {IssueTitle: "Decompression on Main Thread"},
+	category.FileRead:         {IssueTitle: "File I/O on Main Thread"},
+	category.FileWrite:        {IssueTitle: "File I/O on Main Thread"},
+	category.FrameDrop:        {IssueTitle: "Frame Drop", Type: FrameDropType},
+	category.HTTP:             {IssueTitle: "Network I/O on Main Thread"},
+	category.ImageDecode:      {IssueTitle: "Image Decoding on Main Thread", Type: ImageDecodeType},
+	category.ImageEncode:      {IssueTitle: "Image Encoding on Main Thread"},
+	category.JSONDecode:       {IssueTitle: "JSON Decoding on Main Thread", Type: JSONDecodeType},
+	category.JSONEncode:       {IssueTitle: "JSON Encoding on Main Thread"},
+	category.MLModelInference: {IssueTitle: "Machine Learning inference on Main Thread"},
+	category.MLModelLoad:      {IssueTitle: "Machine Learning model load on Main Thread"},
+	category.Regex:            {IssueTitle: "Regex on Main Thread", Type: RegexType},
+	category.SQL:              {IssueTitle: "SQL operation on Main Thread"},
+	category.SourceContext:    {IssueTitle: "Adding Source Context is slow"},
+	category.ThreadWait:       {IssueTitle: "Thread Wait on Main Thread"},
+	category.ViewInflation:    {IssueTitle: "SwiftUI View Inflation is slow"},
+	category.ViewLayout:       {IssueTitle: "SwiftUI View Layout is slow", Type: ViewType},
+	category.ViewRender:       {IssueTitle: "SwiftUI View Render is slow", Type: ViewType},
+	category.ViewUpdate:       {IssueTitle: "SwiftUI View Update is slow", Type: ViewType},
+	category.XPC:              {IssueTitle: "XPC operation on Main Thread"},
 }
 
 // NewOccurrence returns an Occurrence struct populated with info.
@@ -281,6 +283,7 @@ func FromRegressedFunction(
 		Fingerprint: []string{fingerprint},
 		ID:          eventID(),
 		IssueTitle:  issueTitle,
+		category:    category.FunctionRegression,
 		Level:       "info",
 		PayloadType: OccurrencePayload,
 		ProjectID:   regressed.ProjectID,
@@ -293,6 +296,11 @@ func FromRegressedFunction(
 	}
 }
 
+// Category returns the category of the issue detected.
+func (o *Occurrence) Category() category.Category {
+	return o.category
+}
+
 func eventID() string {
 	return strings.ReplaceAll(uuid.New().String(), "-", "")
 }
@@ -317,7 +325,7 @@ func generateEvidenceData(p profile.Profile, ni nodeInfo) map[string]interface{}
 		ProfileID:             p.ID(),
 	}
 	switch ni.Category {
-	case FrameDrop:
+	case category.FrameDrop:
 	default:
 		switch p.Platform() {
 		case platform.Android:
@@ -340,7 +348,7 @@ func generateEvidenceDisplay(p profile.Profile, ni nodeInfo) []Evidence {
 		},
 	}
 	switch ni.Category {
-	case FrameDrop:
+	case category.FrameDrop:
 	default:
 		nodeDuration := time.Duration(ni.Node.DurationNS).Round(10 * time.Microsecond)
 		profilePercentage := float64(ni.Node.DurationNS*100) / float64(p.DurationNS())
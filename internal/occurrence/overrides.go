@@ -0,0 +1,86 @@
+package occurrence
+
+import (
+	"strings"
+	"time"
+
+	"github.com/getsentry/vroom/internal/category"
+	"github.com/getsentry/vroom/internal/utils"
+)
+
+// mutedAllFunctions can be used in a mute list to mute every function of a package.
+const mutedAllFunctions = "*"
+
+// detectionOverrides is a lookup friendly version of utils.DetectionOptions.
+// Its zero value applies no override.
+type detectionOverrides struct {
+	disabledCategories map[category.Category]struct{}
+	durationThresholds map[category.Category]time.Duration
+	mutedFunctions     map[string]map[string]struct{}
+	sampleThresholds   map[category.Category]int
+}
+
+func newDetectionOverrides(options utils.DetectionOptions) detectionOverrides {
+	o := detectionOverrides{
+		disabledCategories: make(map[category.Category]struct{}, len(options.DisabledCategories)),
+		durationThresholds: make(map[category.Category]time.Duration, len(options.DurationThresholdsMS)),
+		mutedFunctions:     make(map[string]map[string]struct{}, len(options.MutedFunctions)),
+		sampleThresholds:   make(map[category.Category]int, len(options.SampleThresholds)),
+	}
+	for _, c := range options.DisabledCategories {
+		o.disabledCategories[category.Category(c)] = struct{}{}
+	}
+	for c, ms := range options.DurationThresholdsMS {
+		o.durationThresholds[category.Category(c)] = time.Duration(ms) * time.Millisecond
+	}
+	for c, threshold := range options.SampleThresholds {
+		o.sampleThresholds[category.Category(c)] = threshold
+	}
+	for pkg, functions := range options.MutedFunctions {
+		muted := make(map[string]struct{}, len(functions))
+		for _, f := range functions {
+			muted[f] = struct{}{}
+		}
+		o.mutedFunctions[pkg] = muted
+	}
+	return o
+}
+
+func (o detectionOverrides) isDisabled(c category.Category) bool {
+	_, disabled := o.disabledCategories[c]
+	return disabled
+}
+
+// isMuted returns true if the function was muted for the project. Android
+// function names contain their signature, we also match on the name without it.
+func (o detectionOverrides) isMuted(pkg, function string) bool {
+	functions, exists := o.mutedFunctions[pkg]
+	if !exists {
+		return false
+	}
+	if _, exists := functions[mutedAllFunctions]; exists {
+		return true
+	}
+	if _, exists := functions[function]; exists {
+		return true
+	}
+	if i := strings.Index(function, "("); i > 0 {
+		_, exists := functions[function[:i]]
+		return exists
+	}
+	return false
+}
+
+func (o detectionOverrides) durationThreshold(c category.Category, defaultThreshold time.Duration) time.Duration {
+	if threshold, exists := o.durationThresholds[c]; exists {
+		return threshold
+	}
+	return defaultThreshold
+}
+
+func (o detectionOverrides) sampleThreshold(c category.Category, defaultThreshold int) int {
+	if threshold, exists := o.sampleThresholds[c]; exists {
+		return threshold
+	}
+	return defaultThreshold
+}
@@ -0,0 +1,127 @@
+package occurrence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getsentry/vroom/internal/category"
+	"github.com/getsentry/vroom/internal/frame"
+	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/testutil"
+	"github.com/getsentry/vroom/internal/utils"
+)
+
+func TestDetectFrameWithOverrides(t *testing.T) {
+	job := DetectAndroidFrameOptions{
+		DurationThreshold: 16 * time.Millisecond,
+		FunctionsByPackage: map[string]map[string]category.Category{
+			"android.graphics": {
+				"android.graphics.BitmapFactory.decodeStream": category.ImageDecode,
+			},
+		},
+	}
+	newNode := func() *nodetree.Node {
+		name := "android.graphics.BitmapFactory.decodeStream(java.io.InputStream): android.graphics.Bitmap"
+		return &nodetree.Node{
+			DurationNS:    uint64(30 * time.Millisecond),
+			EndNS:         uint64(30 * time.Millisecond),
+			IsApplication: true,
+			Name:          name,
+			Package:       "android.graphics",
+			SampleCount:   3,
+			Frame: frame.Frame{
+				Function: name,
+				InApp:    &testutil.True,
+				Package:  "android.graphics",
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		options utils.DetectionOptions
+		want    int
+	}{
+		{
+			name: "No overrides",
+			want: 1,
+		},
+		{
+			name: "Disabled category",
+			options: utils.DetectionOptions{
+				DisabledCategories: []string{string(category.ImageDecode)},
+			},
+			want: 0,
+		},
+		{
+			name: "Muted function without its signature",
+			options: utils.DetectionOptions{
+				MutedFunctions: map[string][]string{
+					"android.graphics": {"android.graphics.BitmapFactory.decodeStream"},
+				},
+			},
+			want: 0,
+		},
+		{
+			name: "Muted package",
+			options: utils.DetectionOptions{
+				MutedFunctions: map[string][]string{
+					"android.graphics": {mutedAllFunctions},
+				},
+			},
+			want: 0,
+		},
+		{
+			name: "Higher duration threshold",
+			options: utils.DetectionOptions{
+				DurationThresholdsMS: map[string]uint64{string(category.ImageDecode): 50},
+			},
+			want: 0,
+		},
+		{
+			name: "Higher sample threshold",
+			options: utils.DetectionOptions{
+				SampleThresholds: map[string]int{string(category.ImageDecode): 5},
+			},
+			want: 0,
+		},
+		{
+			name: "Override for another category",
+			options: utils.DetectionOptions{
+				DisabledCategories:   []string{string(category.FileRead)},
+				DurationThresholdsMS: map[string]uint64{string(category.FileRead): 50},
+			},
+			want: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes := make(map[nodeKey]nodeInfo)
+			detectFrameInCallTree(newNode(), job, newDetectionOverrides(tt.options), nodes)
+			if len(nodes) != tt.want {
+				t.Fatalf("expected %d nodes, got %d", tt.want, len(nodes))
+			}
+		})
+	}
+}
+
+func TestMergeDetectionOptions(t *testing.T) {
+	project := utils.DetectionOptions{
+		DisabledCategories:   []string{string(category.FileRead)},
+		DurationThresholdsMS: map[string]uint64{string(category.ImageDecode): 50, string(category.JSONDecode): 20},
+		MutedFunctions:       map[string][]string{"app": {"load"}},
+	}
+	profileOptions := utils.DetectionOptions{
+		DurationThresholdsMS: map[string]uint64{string(category.ImageDecode): 100},
+		MutedFunctions:       map[string][]string{"app": {"save"}},
+	}
+	want := utils.DetectionOptions{
+		DisabledCategories:   []string{string(category.FileRead)},
+		DurationThresholdsMS: map[string]uint64{string(category.ImageDecode): 100, string(category.JSONDecode): 20},
+		SampleThresholds:     map[string]int{},
+		MutedFunctions:       map[string][]string{"app": {"load", "save"}},
+	}
+	if diff := testutil.Diff(project.Merge(profileOptions), want); diff != "" {
+		t.Fatalf("Result mismatch: got - want +\n%s", diff)
+	}
+}
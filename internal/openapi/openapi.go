@@ -0,0 +1,257 @@
+// Package openapi holds the OpenAPI document describing the HTTP API and
+// validates requests against it.
+//
+// Only the subset of OpenAPI 3.0 the document uses is supported: path and
+// query parameters, JSON request bodies and schemas made of type, nullable,
+// properties, required, additionalProperties, items, enum, oneOf, minimum,
+// exclusiveMinimum, maximum, pattern and the uuid format, with references to
+// the components.
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Raw is the OpenAPI document served to clients.
+//
+//go:embed openapi.json
+var Raw []byte
+
+type (
+	// Document is the part of the OpenAPI document needed to validate
+	// requests.
+	Document struct {
+		Paths      map[string]PathItem `json:"paths"`
+		Components Components          `json:"components"`
+	}
+
+	Components struct {
+		Parameters map[string]*Parameter `json:"parameters"`
+		Schemas    map[string]*Schema    `json:"schemas"`
+	}
+
+	PathItem struct {
+		Get    *Operation `json:"get"`
+		Post   *Operation `json:"post"`
+		Put    *Operation `json:"put"`
+		Delete *Operation `json:"delete"`
+	}
+
+	Operation struct {
+		OperationID string       `json:"operationId"`
+		Parameters  []*Parameter `json:"parameters"`
+		RequestBody *RequestBody `json:"requestBody"`
+		// SkipBodyValidation documents a body the handler checks itself,
+		// it's too large to be decoded twice.
+		SkipBodyValidation bool `json:"x-vroom-skip-body-validation"`
+	}
+
+	Parameter struct {
+		Ref      string  `json:"$ref"`
+		Name     string  `json:"name"`
+		In       string  `json:"in"`
+		Required bool    `json:"required"`
+		Schema   *Schema `json:"schema"`
+	}
+
+	RequestBody struct {
+		Required bool                 `json:"required"`
+		Content  map[string]MediaType `json:"content"`
+	}
+
+	MediaType struct {
+		Schema *Schema `json:"schema"`
+	}
+
+	Schema struct {
+		Ref                  string             `json:"$ref"`
+		Type                 string             `json:"type"`
+		Format               string             `json:"format"`
+		Nullable             bool               `json:"nullable"`
+		Properties           map[string]*Schema `json:"properties"`
+		Required             []string           `json:"required"`
+		AdditionalProperties *Schema            `json:"additionalProperties"`
+		Items                *Schema            `json:"items"`
+		Enum                 []interface{}      `json:"enum"`
+		OneOf                []*Schema          `json:"oneOf"`
+		Minimum              *float64           `json:"minimum"`
+		ExclusiveMinimum     bool               `json:"exclusiveMinimum"`
+		Maximum              *float64           `json:"maximum"`
+		Pattern              string             `json:"pattern"`
+
+		pattern *regexp.Regexp
+	}
+)
+
+const (
+	parameterRefPrefix = "#/components/parameters/"
+	schemaRefPrefix    = "#/components/schemas/"
+	jsonContentType    = "application/json"
+)
+
+var types = map[string]struct{}{
+	"":        {},
+	"array":   {},
+	"boolean": {},
+	"integer": {},
+	"number":  {},
+	"object":  {},
+	"string":  {},
+}
+
+// Parse reads an OpenAPI document and resolves its references.
+func Parse(b []byte) (*Document, error) {
+	var d Document
+	err := json.Unmarshal(b, &d)
+	if err != nil {
+		return nil, err
+	}
+	r := resolver{document: &d, seen: make(map[*Schema]struct{})}
+	for _, s := range d.Components.Schemas {
+		if _, err := r.schema(s); err != nil {
+			return nil, err
+		}
+	}
+	for path, item := range d.Paths {
+		for _, o := range item.operations() {
+			if err := r.operation(o); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+		}
+	}
+	return &d, nil
+}
+
+func (p PathItem) operations() []*Operation {
+	var operations []*Operation
+	for _, o := range []*Operation{p.Get, p.Post, p.Put, p.Delete} {
+		if o != nil {
+			operations = append(operations, o)
+		}
+	}
+	return operations
+}
+
+func (p PathItem) operation(method string) *Operation {
+	switch method {
+	case "GET":
+		return p.Get
+	case "POST":
+		return p.Post
+	case "PUT":
+		return p.Put
+	case "DELETE":
+		return p.Delete
+	}
+	return nil
+}
+
+// Operation returns the operation documented for an httprouter route.
+func (d *Document) Operation(method, route string) (*Operation, error) {
+	segments := strings.Split(route, "/")
+	for i, s := range segments {
+		if strings.HasPrefix(s, ":") || strings.HasPrefix(s, "*") {
+			segments[i] = "{" + s[1:] + "}"
+		}
+	}
+	item, exists := d.Paths[strings.Join(segments, "/")]
+	if !exists {
+		return nil, fmt.Errorf("openapi: %s %s isn't documented", method, route)
+	}
+	o := item.operation(method)
+	if o == nil {
+		return nil, fmt.Errorf("openapi: %s %s isn't documented", method, route)
+	}
+	return o, nil
+}
+
+type resolver struct {
+	document *Document
+	seen     map[*Schema]struct{}
+}
+
+func (r resolver) operation(o *Operation) error {
+	for i, p := range o.Parameters {
+		if p.Ref != "" {
+			resolved, exists := r.document.Components.Parameters[strings.TrimPrefix(p.Ref, parameterRefPrefix)]
+			if !exists || !strings.HasPrefix(p.Ref, parameterRefPrefix) {
+				return fmt.Errorf("openapi: unknown parameter %s", p.Ref)
+			}
+			p = resolved
+			o.Parameters[i] = p
+		}
+		if p.In != "path" && p.In != "query" {
+			return fmt.Errorf("openapi: parameter %s in %s isn't supported", p.Name, p.In)
+		}
+		s, err := r.schema(p.Schema)
+		if err != nil {
+			return err
+		}
+		p.Schema = s
+	}
+	if o.RequestBody == nil {
+		return nil
+	}
+	media, exists := o.RequestBody.Content[jsonContentType]
+	if !exists {
+		return fmt.Errorf("openapi: request bodies have to be %s", jsonContentType)
+	}
+	s, err := r.schema(media.Schema)
+	if err != nil {
+		return err
+	}
+	media.Schema = s
+	o.RequestBody.Content[jsonContentType] = media
+	return nil
+}
+
+// schema replaces the references of the schema and of the ones it contains
+// by the schemas they point to.
+func (r resolver) schema(s *Schema) (*Schema, error) {
+	if s == nil {
+		return nil, nil
+	}
+	if s.Ref != "" {
+		resolved, exists := r.document.Components.Schemas[strings.TrimPrefix(s.Ref, schemaRefPrefix)]
+		if !exists || !strings.HasPrefix(s.Ref, schemaRefPrefix) {
+			return nil, fmt.Errorf("openapi: unknown schema %s", s.Ref)
+		}
+		s = resolved
+	}
+	if _, done := r.seen[s]; done {
+		return s, nil
+	}
+	r.seen[s] = struct{}{}
+	if _, exists := types[s.Type]; !exists {
+		return nil, fmt.Errorf("openapi: type %s isn't supported", s.Type)
+	}
+	if s.Pattern != "" {
+		re, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		s.pattern = re
+	}
+	var err error
+	for name, p := range s.Properties {
+		if s.Properties[name], err = r.schema(p); err != nil {
+			return nil, err
+		}
+	}
+	for i, o := range s.OneOf {
+		if s.OneOf[i], err = r.schema(o); err != nil {
+			return nil, err
+		}
+	}
+	if s.Items, err = r.schema(s.Items); err != nil {
+		return nil, err
+	}
+	if s.AdditionalProperties, err = r.schema(s.AdditionalProperties); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
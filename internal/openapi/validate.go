@@ -0,0 +1,281 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/julienschmidt/httprouter"
+)
+
+type (
+	// Error is a reason a request doesn't match the document.
+	Error struct {
+		// Location is path, query or body.
+		Location string `json:"location"`
+		// Name is the name of the parameter or the JSON pointer to the
+		// value of the body.
+		Name    string `json:"name,omitempty"`
+		Message string `json:"message"`
+	}
+
+	validator struct {
+		location string
+		errors   []Error
+	}
+)
+
+const (
+	LocationPath  = "path"
+	LocationQuery = "query"
+	LocationBody  = "body"
+
+	// maxErrors stops the validation of a request once reached so a large
+	// invalid body doesn't produce a larger response.
+	maxErrors = 50
+)
+
+// ValidateParameters checks the path and query parameters of the request.
+func (o *Operation) ValidateParameters(r *http.Request) []Error {
+	ps := httprouter.ParamsFromContext(r.Context())
+	qs := r.URL.Query()
+	var errors []Error
+	for _, p := range o.Parameters {
+		var raw string
+		var present bool
+		switch p.In {
+		case LocationPath:
+			raw = ps.ByName(p.Name)
+			present = raw != ""
+		case LocationQuery:
+			raw = qs.Get(p.Name)
+			present = qs.Has(p.Name)
+		}
+		if !present {
+			if p.Required {
+				errors = append(errors, Error{Location: p.In, Name: p.Name, Message: "is required"})
+			}
+			continue
+		}
+		v := &validator{location: p.In}
+		v.value(p.Schema, parameterValue(p.Schema, raw), p.Name)
+		errors = append(errors, v.errors...)
+	}
+	return errors
+}
+
+// parameterValue converts a parameter to the type of value its schema
+// expects in a JSON document. Values which can't be converted are left as
+// strings and reported by the validation.
+func parameterValue(s *Schema, raw string) interface{} {
+	if s == nil {
+		return raw
+	}
+	switch s.Type {
+	case "integer", "number":
+		if _, err := strconv.ParseFloat(raw, 64); err == nil {
+			return json.Number(raw)
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(raw); err == nil {
+			return b
+		}
+	}
+	return raw
+}
+
+// ValidatesBody returns whether the body of the operation is checked against
+// its schema.
+func (o *Operation) ValidatesBody() bool {
+	return o.RequestBody != nil && !o.SkipBodyValidation
+}
+
+// ValidateBody checks a JSON body against the schema of the operation.
+func (o *Operation) ValidateBody(body []byte) []Error {
+	if !o.ValidatesBody() {
+		return nil
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		if o.RequestBody.Required {
+			return []Error{{Location: LocationBody, Message: "is required"}}
+		}
+		return nil
+	}
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	var value interface{}
+	if err := d.Decode(&value); err != nil {
+		return []Error{{Location: LocationBody, Message: fmt.Sprintf("invalid JSON: %v", err)}}
+	}
+	v := &validator{location: LocationBody}
+	v.value(o.RequestBody.Content[jsonContentType].Schema, value, "")
+	return v.errors
+}
+
+func (v *validator) fail(name, format string, args ...interface{}) {
+	if len(v.errors) < maxErrors {
+		v.errors = append(v.errors, Error{
+			Location: v.location,
+			Name:     name,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+}
+
+func (v *validator) full() bool {
+	return len(v.errors) >= maxErrors
+}
+
+func (v *validator) value(s *Schema, value interface{}, name string) {
+	if s == nil || v.full() {
+		return
+	}
+	if value == nil {
+		if !s.Nullable && s.Type != "" {
+			v.fail(name, "must not be null")
+		}
+		return
+	}
+	if len(s.OneOf) > 0 {
+		v.oneOf(s, value, name)
+	}
+	switch s.Type {
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			v.fail(name, "must be an object")
+			return
+		}
+		v.object(s, object, name)
+	case "array":
+		array, ok := value.([]interface{})
+		if !ok {
+			v.fail(name, "must be an array")
+			return
+		}
+		for i, item := range array {
+			v.value(s.Items, item, name+"/"+strconv.Itoa(i))
+		}
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			v.fail(name, "must be a string")
+			return
+		}
+		v.string(s, str, name)
+	case "integer", "number":
+		number, ok := value.(json.Number)
+		if !ok {
+			v.fail(name, "must be a number")
+			return
+		}
+		v.number(s, number, name)
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			v.fail(name, "must be a boolean")
+			return
+		}
+	}
+	if len(s.Enum) > 0 && !inEnum(s.Enum, value) {
+		v.fail(name, "must be one of %s", formatEnum(s.Enum))
+	}
+}
+
+func (v *validator) oneOf(s *Schema, value interface{}, name string) {
+	matches := 0
+	for _, o := range s.OneOf {
+		candidate := &validator{location: v.location}
+		candidate.value(o, value, name)
+		if len(candidate.errors) == 0 {
+			matches++
+		}
+	}
+	if matches != 1 {
+		v.fail(name, "must match exactly one schema, matches %d", matches)
+	}
+}
+
+func (v *validator) object(s *Schema, object map[string]interface{}, name string) {
+	for _, required := range s.Required {
+		if _, exists := object[required]; !exists {
+			v.fail(name+"/"+escapePointer(required), "is required")
+		}
+	}
+	// Sorting the keys keeps the errors in the same order between calls.
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		property, exists := s.Properties[key]
+		if !exists {
+			property = s.AdditionalProperties
+		}
+		v.value(property, object[key], name+"/"+escapePointer(key))
+	}
+}
+
+func (v *validator) string(s *Schema, str, name string) {
+	if s.pattern != nil && !s.pattern.MatchString(str) {
+		v.fail(name, "must match %s", s.Pattern)
+	}
+	if s.Format == "uuid" {
+		if _, err := uuid.Parse(str); err != nil {
+			v.fail(name, "must be a UUID")
+		}
+	}
+}
+
+func (v *validator) number(s *Schema, number json.Number, name string) {
+	if s.Type == "integer" {
+		_, errInt := strconv.ParseInt(number.String(), 10, 64)
+		_, errUint := strconv.ParseUint(number.String(), 10, 64)
+		if errInt != nil && errUint != nil {
+			v.fail(name, "must be an integer")
+			return
+		}
+	}
+	f, err := number.Float64()
+	if err != nil {
+		v.fail(name, "must be a number")
+		return
+	}
+	if s.Minimum != nil {
+		if s.ExclusiveMinimum && f <= *s.Minimum {
+			v.fail(name, "must be greater than %v", *s.Minimum)
+		} else if f < *s.Minimum {
+			v.fail(name, "must be greater than or equal to %v", *s.Minimum)
+		}
+	}
+	if s.Maximum != nil && f > *s.Maximum {
+		v.fail(name, "must be less than or equal to %v", *s.Maximum)
+	}
+}
+
+func inEnum(enum []interface{}, value interface{}) bool {
+	for _, e := range enum {
+		if fmt.Sprint(e) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatEnum(enum []interface{}) string {
+	values := make([]string, 0, len(enum))
+	for _, e := range enum {
+		values = append(values, strconv.Quote(fmt.Sprint(e)))
+	}
+	return strings.Join(values, ", ")
+}
+
+// escapePointer escapes a key to be used in a JSON pointer.
+func escapePointer(key string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
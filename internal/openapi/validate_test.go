@@ -0,0 +1,154 @@
+package openapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/getsentry/vroom/internal/testutil"
+)
+
+func TestParse(t *testing.T) {
+	if _, err := Parse(Raw); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := Parse([]byte(`{"components":{"schemas":{"A":{"$ref":"#/components/schemas/B"}}}}`)); err == nil {
+		t.Fatalf("expected an error for an unknown reference")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	d, err := Parse(Raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		route  string
+		target string
+		body   string
+		want   []Error
+	}{
+		{
+			name:   "valid parameters",
+			method: http.MethodGet,
+			route:  "/organizations/:organization_id/projects/:project_id/profiles/:profile_id/functions",
+			target: "/organizations/1/projects/2/profiles/f2a1b6f3-5e1f-4b8e-9d3c-6f6a1c8e2b7d/functions?sort=total_time&in_app=true&limit=10",
+		},
+		{
+			name:   "invalid parameters",
+			method: http.MethodGet,
+			route:  "/organizations/:organization_id/projects/:project_id/profiles/:profile_id/functions",
+			target: "/organizations/abc/projects/2/profiles/not-a-uuid/functions?sort=name&in_app=maybe&limit=0",
+			want: []Error{
+				{Location: LocationPath, Name: "organization_id", Message: "must be a number"},
+				{Location: LocationPath, Name: "profile_id", Message: "must be a UUID"},
+				{Location: LocationQuery, Name: "sort", Message: `must be one of "self_time", "total_time", "sample_count"`},
+				{Location: LocationQuery, Name: "in_app", Message: "must be a boolean"},
+				{Location: LocationQuery, Name: "limit", Message: "must be greater than or equal to 1"},
+			},
+		},
+		{
+			name:   "valid body",
+			method: http.MethodPost,
+			route:  "/organizations/:organization_id/metrics",
+			target: "/organizations/1/metrics",
+			body:   `{"transaction":[{"project_id":1,"profile_id":"a"}],"continuous":null,"quantiles":[0.5],"group_by":["release"],"rank_by":"total_time","interval":60}`,
+		},
+		{
+			name:   "invalid body",
+			method: http.MethodPost,
+			route:  "/organizations/:organization_id/metrics",
+			target: "/organizations/1/metrics",
+			body:   `{"transaction":[{"project_id":-1}],"continuous":[{"project_id":1,"profiler_id":"a","chunk_id":"b","start":"1","end":2}],"quantiles":[0],"group_by":["user"]}`,
+			want: []Error{
+				{Location: LocationBody, Name: "/continuous/0/end", Message: "must be a string"},
+				{Location: LocationBody, Name: "/group_by/0", Message: `must be one of "device_classification", "device_model", "environment", "os_version", "platform", "release", "transaction_name"`},
+				{Location: LocationBody, Name: "/quantiles/0", Message: "must be greater than 0"},
+				{Location: LocationBody, Name: "/transaction/0/profile_id", Message: "is required"},
+				{Location: LocationBody, Name: "/transaction/0/project_id", Message: "must be greater than or equal to 0"},
+			},
+		},
+		{
+			name:   "optional time range",
+			method: http.MethodPost,
+			route:  "/organizations/:organization_id/projects/:project_id/chunks",
+			target: "/organizations/1/projects/2/chunks",
+			body:   `{"profiler_id":"a","chunk_ids":["b"]}`,
+		},
+		{
+			name:   "continuous candidate without time range",
+			method: http.MethodPost,
+			route:  "/organizations/:organization_id/flamegraph",
+			target: "/organizations/1/flamegraph",
+			body:   `{"continuous":[{"project_id":1,"profiler_id":"a","chunk_id":"b"}]}`,
+		},
+		{
+			name:   "array body",
+			method: http.MethodPost,
+			route:  "/regressed",
+			target: "/regressed",
+			body:   `[{"organization_id":1,"project_id":1,"profile_id":"a","fingerprint":4294967296}]`,
+			want: []Error{
+				{Location: LocationBody, Name: "/0/fingerprint", Message: "must be less than or equal to 4.294967295e+09"},
+			},
+		},
+		{
+			name:   "missing body",
+			method: http.MethodPost,
+			route:  "/organizations/:organization_id/projects/:project_id/chunks",
+			target: "/organizations/1/projects/2/chunks",
+			want:   []Error{{Location: LocationBody, Message: "is required"}},
+		},
+		{
+			name:   "invalid JSON",
+			method: http.MethodPost,
+			route:  "/organizations/:organization_id/projects/:project_id/chunks",
+			target: "/organizations/1/projects/2/chunks",
+			body:   `{"profiler_id":`,
+			want:   []Error{{Location: LocationBody, Message: "invalid JSON: unexpected EOF"}},
+		},
+		{
+			name:   "body validated by the handler",
+			method: http.MethodPost,
+			route:  "/profile",
+			target: "/profile",
+			body:   `[]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			o, err := d.Operation(test.method, test.route)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var errors []Error
+			router := httprouter.New()
+			router.HandlerFunc(test.method, test.route, func(_ http.ResponseWriter, r *http.Request) {
+				errors = append(o.ValidateParameters(r), o.ValidateBody([]byte(test.body))...)
+			})
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(test.method, test.target, nil))
+			if diff := testutil.Diff(errors, test.want); diff != "" {
+				t.Fatalf("Result mismatch: got - want +\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOperationNotDocumented(t *testing.T) {
+	d, err := Parse(Raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := d.Operation(http.MethodDelete, "/profile"); err == nil {
+		t.Fatalf("expected an error for an undocumented method")
+	}
+	if _, err := d.Operation(http.MethodGet, "/undocumented"); err == nil {
+		t.Fatalf("expected an error for an undocumented route")
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/getsentry/vroom/internal/nodetree"
 	"github.com/getsentry/vroom/internal/packageutil"
 	"github.com/getsentry/vroom/internal/platform"
+	"github.com/getsentry/vroom/internal/sample"
 	"github.com/getsentry/vroom/internal/speedscope"
 )
 
@@ -243,6 +244,26 @@ func (p *Android) FixSamplesTime() {
 	}
 }
 
+// Validate checks the trace is within the limits, events counting as samples
+// and methods as frames, and that events reference existing methods.
+func (p Android) Validate(limits sample.Limits) error {
+	if err := limits.CheckCounts(len(p.Events), len(p.Methods), len(p.Threads)); err != nil {
+		return err
+	}
+	methods := make(map[uint64]struct{}, len(p.Methods))
+	for _, m := range p.Methods {
+		methods[m.ID] = struct{}{}
+	}
+	for i, e := range p.Events {
+		if _, exists := methods[e.MethodID]; !exists {
+			return &sample.ValidationError{
+				Reason: fmt.Sprintf("event %d references unknown method %d", i, e.MethodID),
+			}
+		}
+	}
+	return nil
+}
+
 // CallTrees generates call trees for a given profile.
 func (p Android) CallTrees() map[uint64][]*nodetree.Node {
 	return p.CallTreesWithMaxDepth(MaxStackDepth)
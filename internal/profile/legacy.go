@@ -185,6 +185,16 @@ func (p LegacyProfile) CallTrees() (map[uint64][]*nodetree.Node, error) {
 	return p.Trace.CallTrees(), nil
 }
 
+// Validate checks the Android trace of the profile, the only platform of
+// legacy profiles, is within the limits and consistent before it's stored.
+func (p LegacyProfile) Validate(limits sample.Limits) error {
+	t, ok := p.Trace.(*Android)
+	if !ok {
+		return nil
+	}
+	return t.Validate(limits)
+}
+
 func (p LegacyProfile) IsSampleFormat() bool {
 	return false
 }
@@ -2,6 +2,7 @@ package profile
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/getsentry/vroom/internal/frame"
@@ -337,3 +338,72 @@ func TestNormalizeLegacyAndroidProfile(t *testing.T) {
 		})
 	}
 }
+
+func TestLegacyProfileValidate(t *testing.T) {
+	limits := sample.Limits{MaxSamples: 2, MaxFrames: 2, MaxThreads: 1}
+	tests := []struct {
+		name    string
+		trace   Android
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			trace: Android{
+				Methods: []AndroidMethod{{ID: 1}, {ID: 2}},
+				Threads: []AndroidThread{{ID: 1}},
+				Events: []AndroidEvent{
+					{Action: EnterAction, ThreadID: 1, MethodID: 1},
+					{Action: ExitAction, ThreadID: 1, MethodID: 1},
+				},
+			},
+		},
+		{
+			name: "too many events",
+			trace: Android{
+				Methods: []AndroidMethod{{ID: 1}},
+				Events:  []AndroidEvent{{MethodID: 1}, {MethodID: 1}, {MethodID: 1}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "too many methods",
+			trace: Android{
+				Methods: []AndroidMethod{{ID: 1}, {ID: 2}, {ID: 3}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "too many threads",
+			trace: Android{
+				Threads: []AndroidThread{{ID: 1}, {ID: 2}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown method",
+			trace: Android{
+				Methods: []AndroidMethod{{ID: 1}},
+				Events:  []AndroidEvent{{MethodID: 2}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			trace := test.trace
+			p := LegacyProfile{Trace: &trace}
+			err := p.Validate(limits)
+			if !test.wantErr {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var validationErr *sample.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected a validation error, got %v", err)
+			}
+		})
+	}
+}
@@ -42,6 +42,7 @@ type (
 		IsSampled() bool
 		SetProfileID(ID string)
 		GetOptions() utils.Options
+		Validate(limits sample.Limits) error
 	}
 
 	Profile struct {
@@ -102,6 +103,12 @@ func (p *Profile) StoragePath() string {
 	return p.profile.StoragePath()
 }
 
+// Validate checks the profile can be stored. It returns a
+// *sample.ValidationError explaining why it can't.
+func (p *Profile) Validate(limits sample.Limits) error {
+	return p.profile.Validate(limits)
+}
+
 func (p *Profile) IsSampleFormat() bool {
 	return p.profile.IsSampleFormat()
 }
@@ -36,6 +36,14 @@ func (job ReadJob) Read() {
 	job.Result <- ReadJobResult{Profile: profile, Err: err}
 }
 
+func (job ReadJob) Context() context.Context {
+	return job.Ctx
+}
+
+func (job ReadJob) Skip(err error) {
+	job.Result <- ReadJobResult{Err: err}
+}
+
 func (result ReadJobResult) Error() error {
 	return result.Err
 }
@@ -0,0 +1,128 @@
+// Package ratelimit limits the rate of requests of organizations with token
+// buckets.
+package ratelimit
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"sync"
+	"time"
+)
+
+type (
+	// Limit is the sustained number of requests per second and the number of
+	// requests allowed at once. A zero rate disables the limit.
+	Limit struct {
+		Rate  float64 `json:"rate"`
+		Burst int     `json:"burst"`
+	}
+
+	// Overrides replace the default limits of some organizations.
+	Overrides struct {
+		Ingest map[uint64]Limit `json:"ingest"`
+		Read   map[uint64]Limit `json:"read"`
+	}
+
+	// Limiter keeps a bucket for each organization and project. The limit
+	// applies to each project of an organization separately, use a project ID
+	// of 0 to limit the organization as a whole.
+	Limiter struct {
+		mu        sync.Mutex
+		limit     Limit
+		overrides map[uint64]Limit
+		buckets   map[key]*bucket
+		calls     int
+		now       func() time.Time
+	}
+
+	key struct {
+		organizationID uint64
+		projectID      uint64
+	}
+
+	bucket struct {
+		tokens float64
+		last   time.Time
+	}
+)
+
+// cleanupInterval is the number of calls between two removals of the
+// buckets which are full again.
+const cleanupInterval = 10000
+
+// ReadOverrides reads a JSON file of per organization limits.
+func ReadOverrides(path string) (Overrides, error) {
+	var overrides Overrides
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return overrides, err
+	}
+	err = json.Unmarshal(b, &overrides)
+	return overrides, err
+}
+
+func NewLimiter(limit Limit, overrides map[uint64]Limit) *Limiter {
+	return &Limiter{
+		limit:     limit,
+		overrides: overrides,
+		buckets:   make(map[key]*bucket),
+		now:       time.Now,
+	}
+}
+
+func (l *Limiter) limitFor(organizationID uint64) Limit {
+	if limit, ok := l.overrides[organizationID]; ok {
+		return limit
+	}
+	return l.limit
+}
+
+// Allow takes a token from the bucket. When it's empty, it returns false and
+// how long to wait for the next token. A nil limiter allows everything.
+func (l *Limiter) Allow(organizationID, projectID uint64) (bool, time.Duration) {
+	if l == nil {
+		return true, 0
+	}
+	limit := l.limitFor(organizationID)
+	if limit.Rate <= 0 {
+		return true, 0
+	}
+	burst := math.Max(float64(limit.Burst), 1)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.calls++
+	if l.calls%cleanupInterval == 0 {
+		l.cleanup(now)
+	}
+
+	k := key{organizationID: organizationID, projectID: projectID}
+	b, exists := l.buckets[k]
+	if !exists {
+		b = &bucket{tokens: burst, last: now}
+		l.buckets[k] = b
+	}
+	b.tokens = math.Min(burst, b.tokens+now.Sub(b.last).Seconds()*limit.Rate)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	wait := time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+	return false, wait
+}
+
+// cleanup removes the buckets which would be full, they're the same as new
+// ones. It has to be called with the lock held.
+func (l *Limiter) cleanup(now time.Time) {
+	for k, b := range l.buckets {
+		limit := l.limitFor(k.organizationID)
+		burst := math.Max(float64(limit.Burst), 1)
+		if limit.Rate <= 0 || b.tokens+now.Sub(b.last).Seconds()*limit.Rate >= burst {
+			delete(l.buckets, k)
+		}
+	}
+}
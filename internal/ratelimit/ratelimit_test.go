@@ -0,0 +1,65 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimiter(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := NewLimiter(Limit{Rate: 2, Burst: 3}, map[uint64]Limit{
+		2: {Rate: 0},
+		3: {Rate: 1, Burst: 1},
+	})
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if ok, _ := l.Allow(1, 1); !ok {
+			t.Fatalf("expected request %d to be allowed by the burst", i)
+		}
+	}
+	ok, retryAfter := l.Allow(1, 1)
+	if ok {
+		t.Fatal("expected request over the burst to be rejected")
+	}
+	if retryAfter != 500*time.Millisecond {
+		t.Fatalf("expected to retry after 500ms, got %v", retryAfter)
+	}
+	if ok, _ := l.Allow(1, 2); !ok {
+		t.Fatal("expected another project to have its own bucket")
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	if ok, _ := l.Allow(1, 1); !ok {
+		t.Fatal("expected a token to be refilled")
+	}
+	if ok, _ := l.Allow(1, 1); ok {
+		t.Fatal("expected the bucket to be empty again")
+	}
+
+	for i := 0; i < 10; i++ {
+		if ok, _ := l.Allow(2, 1); !ok {
+			t.Fatal("expected an organization without limit to be allowed")
+		}
+	}
+
+	if ok, _ := l.Allow(3, 1); !ok {
+		t.Fatal("expected the first request of an overridden organization to be allowed")
+	}
+	if ok, retryAfter := l.Allow(3, 1); ok || retryAfter != time.Second {
+		t.Fatalf("expected the override to apply, got %v %v", ok, retryAfter)
+	}
+}
+
+func TestLimiterCleanup(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := NewLimiter(Limit{Rate: 1, Burst: 1}, nil)
+	l.now = func() time.Time { return now }
+
+	l.Allow(1, 1)
+	now = now.Add(time.Minute)
+	l.cleanup(now)
+	if len(l.buckets) != 0 {
+		t.Fatalf("expected full buckets to be removed, got %d", len(l.buckets))
+	}
+}
@@ -0,0 +1,276 @@
+package regression
+
+import (
+	"container/list"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/getsentry/vroom/internal/nodetree"
+	"github.com/getsentry/vroom/internal/occurrence"
+)
+
+type (
+	Options struct {
+		// MaxPValue is the highest p-value of the t-test for which we consider
+		// the change to be significant.
+		MaxPValue float64
+		// MaxSeries caps the number of series kept in memory, the least
+		// recently updated ones are evicted first. 0 means no limit.
+		MaxSeries int
+		// MaxValuesPerHour caps the number of self times kept for each hour
+		// to compute its percentile.
+		MaxValuesPerHour int
+		// MinHours is the minimum number of hours required on each side of a
+		// breakpoint.
+		MinHours int
+		// MinSamplesPerHour is the minimum number of self times seen in an
+		// hour for it to be part of the series.
+		MinSamplesPerHour int
+		// MinTrendPercentage is the minimum ratio between the P95 after and
+		// before the breakpoint to report a regression.
+		MinTrendPercentage float64
+		// Window is how far back we keep hourly aggregates.
+		Window time.Duration
+	}
+
+	// Detector maintains hourly P95 aggregates of function self times in
+	// memory and finds breakpoints in them. Aggregates are lost on restart.
+	Detector struct {
+		mu      sync.Mutex
+		options Options
+		series  map[seriesKey]*series
+		// recent orders the series from the most to the least recently
+		// updated.
+		recent *list.List
+	}
+
+	seriesKey struct {
+		organizationID uint64
+		projectID      uint64
+		fingerprint    uint32
+	}
+
+	series struct {
+		buckets        map[int64]*bucket
+		lastBreakpoint int64
+		element        *list.Element
+	}
+
+	bucket struct {
+		count     int
+		profileID string
+		values    []uint64
+	}
+
+	hourlyAggregate struct {
+		hour      int64
+		p95       float64
+		profileID string
+	}
+)
+
+var DefaultOptions = Options{
+	MaxPValue:          0.01,
+	MaxSeries:          100_000,
+	MaxValuesPerHour:   200,
+	MinHours:           6,
+	MinSamplesPerHour:  5,
+	MinTrendPercentage: 1.1,
+	Window:             72 * time.Hour,
+}
+
+func NewDetector(options Options) *Detector {
+	return &Detector{
+		options: options,
+		series:  make(map[seriesKey]*series),
+		recent:  list.New(),
+	}
+}
+
+// Len returns the number of series kept in memory.
+func (d *Detector) Len() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.series)
+}
+
+// AddFunctions adds the self times of the functions found in a profile to
+// the hourly aggregates. profileID should be empty if the profile isn't
+// stored since it's used as an example to build the occurrence later.
+func (d *Detector) AddFunctions(
+	organizationID uint64,
+	projectID uint64,
+	profileID string,
+	timestamp time.Time,
+	functions []nodetree.CallTreeFunction,
+) {
+	hour := timestamp.Truncate(time.Hour).Unix()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, f := range functions {
+		if len(f.SelfTimesNS) == 0 {
+			continue
+		}
+		key := seriesKey{
+			organizationID: organizationID,
+			projectID:      projectID,
+			fingerprint:    f.Fingerprint,
+		}
+		s, exists := d.series[key]
+		if !exists {
+			if d.options.MaxSeries > 0 && len(d.series) >= d.options.MaxSeries {
+				d.remove(d.recent.Back().Value.(seriesKey))
+			}
+			s = &series{
+				buckets: make(map[int64]*bucket),
+				element: d.recent.PushFront(key),
+			}
+			d.series[key] = s
+		} else {
+			d.recent.MoveToFront(s.element)
+		}
+		b, exists := s.buckets[hour]
+		if !exists {
+			b = &bucket{}
+			s.buckets[hour] = b
+		}
+		if profileID != "" {
+			b.profileID = profileID
+		}
+		for _, v := range f.SelfTimesNS {
+			b.add(v, d.options.MaxValuesPerHour)
+		}
+	}
+}
+
+func (d *Detector) remove(key seriesKey) {
+	d.recent.Remove(d.series[key].element)
+	delete(d.series, key)
+}
+
+// add keeps a uniform sample of the values with reservoir sampling.
+func (b *bucket) add(v uint64, maxValues int) {
+	b.count++
+	if len(b.values) < maxValues {
+		b.values = append(b.values, v)
+		return
+	}
+	if i := rand.Intn(b.count); i < maxValues {
+		b.values[i] = v
+	}
+}
+
+func (b *bucket) p95() float64 {
+	values := make([]uint64, len(b.values))
+	copy(values, b.values)
+	sort.Slice(values, func(i, j int) bool {
+		return values[i] < values[j]
+	})
+	index := int(math.Ceil(float64(len(values))*0.95)) - 1
+	return float64(values[index])
+}
+
+// Detect looks for a regression in every series using the hours completed
+// before now and returns the ones not reported yet.
+func (d *Detector) Detect(now time.Time) []occurrence.RegressedFunction {
+	currentHour := now.Truncate(time.Hour).Unix()
+	oldestHour := now.Add(-d.options.Window).Truncate(time.Hour).Unix()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var regressed []occurrence.RegressedFunction
+	for key, s := range d.series {
+		aggregates := make([]hourlyAggregate, 0, len(s.buckets))
+		for hour, b := range s.buckets {
+			if hour < oldestHour {
+				delete(s.buckets, hour)
+				continue
+			}
+			if hour >= currentHour || b.count < d.options.MinSamplesPerHour {
+				continue
+			}
+			aggregates = append(aggregates, hourlyAggregate{
+				hour:      hour,
+				p95:       b.p95(),
+				profileID: b.profileID,
+			})
+		}
+		if len(s.buckets) == 0 {
+			d.remove(key)
+			continue
+		}
+		sort.Slice(aggregates, func(i, j int) bool {
+			return aggregates[i].hour < aggregates[j].hour
+		})
+		r, exists := d.findBreakpoint(key, s, aggregates)
+		if !exists {
+			continue
+		}
+		s.lastBreakpoint = int64(r.Breakpoint)
+		regressed = append(regressed, r)
+	}
+	sort.SliceStable(regressed, func(i, j int) bool {
+		return regressed[i].TrendPercentage > regressed[j].TrendPercentage
+	})
+	return regressed
+}
+
+// findBreakpoint returns the split of the series maximizing the t statistic
+// of a Welch's t-test between the hourly P95 before and after it.
+func (d *Detector) findBreakpoint(
+	key seriesKey,
+	s *series,
+	aggregates []hourlyAggregate,
+) (occurrence.RegressedFunction, bool) {
+	if len(aggregates) < 2*d.options.MinHours {
+		return occurrence.RegressedFunction{}, false
+	}
+	values := make([]float64, 0, len(aggregates))
+	for _, a := range aggregates {
+		values = append(values, a.p95)
+	}
+	bestIndex := -1
+	var bestT, bestP float64
+	for i := d.options.MinHours; i <= len(values)-d.options.MinHours; i++ {
+		t, p := welchTTest(values[:i], values[i:])
+		if t > bestT {
+			bestIndex, bestT, bestP = i, t, p
+		}
+	}
+	if bestIndex == -1 || bestP > d.options.MaxPValue {
+		return occurrence.RegressedFunction{}, false
+	}
+	breakpoint := aggregates[bestIndex].hour
+	if breakpoint <= s.lastBreakpoint {
+		return occurrence.RegressedFunction{}, false
+	}
+	before, _ := meanAndVariance(values[:bestIndex])
+	after, _ := meanAndVariance(values[bestIndex:])
+	if before <= 0 || after/before < d.options.MinTrendPercentage {
+		return occurrence.RegressedFunction{}, false
+	}
+	// We need a stored profile after the breakpoint to find the frame.
+	var profileID string
+	for i := len(aggregates) - 1; i >= bestIndex && profileID == ""; i-- {
+		profileID = aggregates[i].profileID
+	}
+	if profileID == "" {
+		return occurrence.RegressedFunction{}, false
+	}
+	return occurrence.RegressedFunction{
+		OrganizationID:           key.organizationID,
+		ProjectID:                key.projectID,
+		ProfileID:                profileID,
+		Fingerprint:              key.fingerprint,
+		AbsolutePercentageChange: after / before,
+		AggregateRange1:          before,
+		AggregateRange2:          after,
+		Breakpoint:               uint64(breakpoint),
+		TrendDifference:          after - before,
+		TrendPercentage:          after / before,
+		UnweightedPValue:         bestP,
+		UnweightedTValue:         bestT,
+	}, true
+}
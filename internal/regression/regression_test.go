@@ -0,0 +1,132 @@
+package regression
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getsentry/vroom/internal/nodetree"
+)
+
+func addHour(d *Detector, hour time.Time, selfTimeNS uint64, profileID string) {
+	for i := 0; i < 10; i++ {
+		d.AddFunctions(1, 2, profileID, hour.Add(time.Duration(i)*time.Minute), []nodetree.CallTreeFunction{
+			{
+				Fingerprint: 42,
+				SelfTimesNS: []uint64{selfTimeNS + uint64(i)*1000},
+			},
+		})
+	}
+}
+
+func TestDetect(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	breakpoint := start.Add(12 * time.Hour)
+	now := start.Add(24*time.Hour + 30*time.Minute)
+
+	t.Run("Regression after the breakpoint", func(t *testing.T) {
+		d := NewDetector(DefaultOptions)
+		for h := start; h.Before(now); h = h.Add(time.Hour) {
+			selfTimeNS := uint64(10 * time.Millisecond)
+			if !h.Before(breakpoint) {
+				selfTimeNS = uint64(20 * time.Millisecond)
+			}
+			addHour(d, h, selfTimeNS, h.Format(time.RFC3339))
+		}
+		regressed := d.Detect(now)
+		if len(regressed) != 1 {
+			t.Fatalf("expected 1 regression, got %d", len(regressed))
+		}
+		r := regressed[0]
+		if r.Breakpoint != uint64(breakpoint.Unix()) {
+			t.Fatalf("breakpoint mismatch: got %v want %v", r.Breakpoint, breakpoint.Unix())
+		}
+		if r.Fingerprint != 42 || r.OrganizationID != 1 || r.ProjectID != 2 {
+			t.Fatalf("unexpected regressed function: %+v", r)
+		}
+		// The current hour isn't complete, the example comes from the previous one.
+		if want := now.Truncate(time.Hour).Add(-time.Hour).Format(time.RFC3339); r.ProfileID != want {
+			t.Fatalf("profile ID mismatch: got %v want %v", r.ProfileID, want)
+		}
+		if r.TrendPercentage < 1.9 {
+			t.Fatalf("expected the duration to double, got %v", r.TrendPercentage)
+		}
+		if regressed := d.Detect(now.Add(time.Minute)); len(regressed) != 0 {
+			t.Fatalf("expected the regression to be reported only once, got %d", len(regressed))
+		}
+	})
+
+	t.Run("Stable series", func(t *testing.T) {
+		d := NewDetector(DefaultOptions)
+		for h := start; h.Before(now); h = h.Add(time.Hour) {
+			addHour(d, h, uint64(10*time.Millisecond), h.Format(time.RFC3339))
+		}
+		if regressed := d.Detect(now); len(regressed) != 0 {
+			t.Fatalf("expected no regression, got %d", len(regressed))
+		}
+	})
+
+	t.Run("Improvement", func(t *testing.T) {
+		d := NewDetector(DefaultOptions)
+		for h := start; h.Before(now); h = h.Add(time.Hour) {
+			selfTimeNS := uint64(20 * time.Millisecond)
+			if !h.Before(breakpoint) {
+				selfTimeNS = uint64(10 * time.Millisecond)
+			}
+			addHour(d, h, selfTimeNS, h.Format(time.RFC3339))
+		}
+		if regressed := d.Detect(now); len(regressed) != 0 {
+			t.Fatalf("expected no regression, got %d", len(regressed))
+		}
+	})
+
+	t.Run("No stored profile", func(t *testing.T) {
+		d := NewDetector(DefaultOptions)
+		for h := start; h.Before(now); h = h.Add(time.Hour) {
+			selfTimeNS := uint64(10 * time.Millisecond)
+			if !h.Before(breakpoint) {
+				selfTimeNS = uint64(20 * time.Millisecond)
+			}
+			addHour(d, h, selfTimeNS, "")
+		}
+		if regressed := d.Detect(now); len(regressed) != 0 {
+			t.Fatalf("expected no regression, got %d", len(regressed))
+		}
+	})
+
+	t.Run("Old hours are dropped", func(t *testing.T) {
+		d := NewDetector(DefaultOptions)
+		addHour(d, start, uint64(10*time.Millisecond), "")
+		d.Detect(start.Add(DefaultOptions.Window + 2*time.Hour))
+		if len(d.series) != 0 {
+			t.Fatalf("expected series to be dropped, got %d", len(d.series))
+		}
+	})
+}
+
+func TestAddFunctionsEvictsLeastRecentlyUpdated(t *testing.T) {
+	options := DefaultOptions
+	options.MaxSeries = 2
+	d := NewDetector(options)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	add := func(fingerprint uint32) {
+		d.AddFunctions(1, 2, "", now, []nodetree.CallTreeFunction{
+			{Fingerprint: fingerprint, SelfTimesNS: []uint64{10}},
+		})
+	}
+	add(1)
+	add(2)
+	add(1)
+	add(3)
+	if d.Len() != 2 {
+		t.Fatalf("expected 2 series, got %d", d.Len())
+	}
+	for fingerprint, want := range map[uint32]bool{1: true, 2: false, 3: true} {
+		_, exists := d.series[seriesKey{organizationID: 1, projectID: 2, fingerprint: fingerprint}]
+		if exists != want {
+			t.Fatalf("series %d: expected kept %v, got %v", fingerprint, want, exists)
+		}
+	}
+	if d.recent.Len() != 2 {
+		t.Fatalf("expected 2 series in the eviction list, got %d", d.recent.Len())
+	}
+}
@@ -0,0 +1,119 @@
+package regression
+
+import "math"
+
+const (
+	betaMaxIterations = 200
+	betaEpsilon       = 3e-14
+	betaMinFloat      = 1e-300
+)
+
+func meanAndVariance(values []float64) (float64, float64) {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+	if len(values) < 2 {
+		return mean, 0
+	}
+	var squares float64
+	for _, v := range values {
+		squares += (v - mean) * (v - mean)
+	}
+	return mean, squares / float64(len(values)-1)
+}
+
+// welchTTest compares the means of 2 samples without assuming equal
+// variances and returns the t statistic and the two-tailed p-value.
+func welchTTest(before, after []float64) (float64, float64) {
+	if len(before) < 2 || len(after) < 2 {
+		return 0, 1
+	}
+	m1, v1 := meanAndVariance(before)
+	m2, v2 := meanAndVariance(after)
+	s1 := v1 / float64(len(before))
+	s2 := v2 / float64(len(after))
+	if s1+s2 == 0 {
+		if m1 == m2 {
+			return 0, 1
+		}
+		return math.Copysign(math.Inf(1), m2-m1), 0
+	}
+	t := (m2 - m1) / math.Sqrt(s1+s2)
+	df := (s1 + s2) * (s1 + s2) /
+		(s1*s1/float64(len(before)-1) + s2*s2/float64(len(after)-1))
+	return t, studentTwoTailedPValue(t, df)
+}
+
+// studentTwoTailedPValue returns P(|T| > |t|) for a Student's t distribution
+// with df degrees of freedom.
+func studentTwoTailedPValue(t, df float64) float64 {
+	if math.IsInf(t, 0) {
+		return 0
+	}
+	return regularizedIncompleteBeta(df/2, 0.5, df/(df+t*t))
+}
+
+// regularizedIncompleteBeta computes I_x(a, b) with the continued fraction
+// representation, converging quickly for x < (a+1)/(a+b+2).
+func regularizedIncompleteBeta(a, b, x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+	lga, _ := math.Lgamma(a)
+	lgb, _ := math.Lgamma(b)
+	lgab, _ := math.Lgamma(a + b)
+	front := math.Exp(lgab - lga - lgb + a*math.Log(x) + b*math.Log(1-x))
+	if x < (a+1)/(a+b+2) {
+		return front * betaContinuedFraction(a, b, x) / a
+	}
+	return 1 - front*betaContinuedFraction(b, a, 1-x)/b
+}
+
+func betaContinuedFraction(a, b, x float64) float64 {
+	qab := a + b
+	qap := a + 1
+	qam := a - 1
+	c := 1.0
+	d := 1 - qab*x/qap
+	if math.Abs(d) < betaMinFloat {
+		d = betaMinFloat
+	}
+	d = 1 / d
+	h := d
+	for m := 1; m <= betaMaxIterations; m++ {
+		fm := float64(m)
+		m2 := 2 * fm
+		aa := fm * (b - fm) * x / ((qam + m2) * (a + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < betaMinFloat {
+			d = betaMinFloat
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < betaMinFloat {
+			c = betaMinFloat
+		}
+		d = 1 / d
+		h *= d * c
+		aa = -(a + fm) * (qab + fm) * x / ((a + m2) * (qap + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < betaMinFloat {
+			d = betaMinFloat
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < betaMinFloat {
+			c = betaMinFloat
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < betaEpsilon {
+			break
+		}
+	}
+	return h
+}
@@ -0,0 +1,45 @@
+package regression
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStudentTwoTailedPValue(t *testing.T) {
+	tests := []struct {
+		name string
+		t    float64
+		df   float64
+		want float64
+	}{
+		{name: "No difference", t: 0, df: 10, want: 1},
+		{name: "t=2 with 10 degrees of freedom", t: 2, df: 10, want: 0.073388},
+		{name: "t=-2 with 10 degrees of freedom", t: -2, df: 10, want: 0.073388},
+		{name: "t=3.5 with 30 degrees of freedom", t: 3.5, df: 30, want: 0.001476},
+		{name: "Infinite t", t: math.Inf(1), df: 5, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := studentTwoTailedPValue(tt.t, tt.df); math.Abs(got-tt.want) > 1e-5 {
+				t.Fatalf("p-value mismatch: got %v want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWelchTTest(t *testing.T) {
+	before := []float64{10, 11, 9, 10, 12, 10}
+	after := []float64{20, 21, 19, 22, 20, 21}
+	tValue, pValue := welchTTest(before, after)
+	if tValue <= 0 {
+		t.Fatalf("expected a positive t statistic, got %v", tValue)
+	}
+	if pValue > 0.001 {
+		t.Fatalf("expected a significant p-value, got %v", pValue)
+	}
+	tValue, pValue = welchTTest(before, before)
+	if tValue != 0 || pValue != 1 {
+		t.Fatalf("expected no difference, got t=%v p=%v", tValue, pValue)
+	}
+}
@@ -0,0 +1,79 @@
+package sample
+
+import (
+	"fmt"
+)
+
+type (
+	// Limits caps the size of the profiles accepted. Zero disables a cap.
+	Limits struct {
+		MaxSamples int
+		MaxFrames  int
+		MaxThreads int
+	}
+
+	// ValidationError explains why a profile was rejected.
+	ValidationError struct {
+		Reason string
+	}
+)
+
+func (e *ValidationError) Error() string {
+	return e.Reason
+}
+
+func invalid(format string, args ...interface{}) error {
+	return &ValidationError{Reason: fmt.Sprintf(format, args...)}
+}
+
+// CheckCounts checks the number of samples, frames and threads against the
+// limits.
+func (l Limits) CheckCounts(samples, frames, threads int) error {
+	if l.MaxSamples > 0 && samples > l.MaxSamples {
+		return invalid("too many samples: %d, the maximum is %d", samples, l.MaxSamples)
+	}
+	if l.MaxFrames > 0 && frames > l.MaxFrames {
+		return invalid("too many frames: %d, the maximum is %d", frames, l.MaxFrames)
+	}
+	if l.MaxThreads > 0 && threads > l.MaxThreads {
+		return invalid("too many threads: %d, the maximum is %d", threads, l.MaxThreads)
+	}
+	return nil
+}
+
+// CheckStacks checks stacks only reference existing frames.
+func CheckStacks(stacks [][]int, numFrames int) error {
+	for i, stack := range stacks {
+		for _, frameID := range stack {
+			if frameID < 0 || frameID >= numFrames {
+				return invalid("stack %d references frame %d out of %d frames", i, frameID, numFrames)
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks the profile is within the limits and consistent before
+// it's stored. Samples have to reference existing stacks, they don't have
+// to be ordered by time since call trees sort them.
+func (p *Profile) Validate(limits Limits) error {
+	t := p.Trace
+	if err := limits.CheckCounts(len(t.Samples), len(t.Frames), 0); err != nil {
+		return err
+	}
+	threads := make(map[uint64]struct{})
+	for i, s := range t.Samples {
+		if s.StackID < 0 || s.StackID >= len(t.Stacks) {
+			return invalid("sample %d references stack %d out of %d stacks", i, s.StackID, len(t.Stacks))
+		}
+		threads[s.ThreadID] = struct{}{}
+	}
+	if err := limits.CheckCounts(0, 0, len(threads)); err != nil {
+		return err
+	}
+	stacks := make([][]int, len(t.Stacks))
+	for i, stack := range t.Stacks {
+		stacks[i] = stack
+	}
+	return CheckStacks(stacks, len(t.Frames))
+}
@@ -0,0 +1,94 @@
+package sample
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/getsentry/vroom/internal/frame"
+)
+
+func TestValidate(t *testing.T) {
+	limits := Limits{MaxSamples: 4, MaxFrames: 2, MaxThreads: 1}
+	tests := []struct {
+		name    string
+		trace   Trace
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			trace: Trace{
+				Frames:  []frame.Frame{{Function: "a"}, {Function: "b"}},
+				Stacks:  []Stack{{0}, {1, 0}},
+				Samples: []Sample{{StackID: 0, ElapsedSinceStartNS: 10}, {StackID: 1, ElapsedSinceStartNS: 10}},
+			},
+		},
+		{
+			name: "too many samples",
+			trace: Trace{
+				Frames:  []frame.Frame{{Function: "a"}},
+				Stacks:  []Stack{{0}},
+				Samples: []Sample{{}, {}, {}, {}, {}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "too many frames",
+			trace: Trace{
+				Frames: []frame.Frame{{}, {}, {}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "too many threads",
+			trace: Trace{
+				Frames:  []frame.Frame{{Function: "a"}},
+				Stacks:  []Stack{{0}},
+				Samples: []Sample{{ThreadID: 1}, {ThreadID: 2}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "stack out of bounds",
+			trace: Trace{
+				Frames:  []frame.Frame{{Function: "a"}},
+				Stacks:  []Stack{{0}},
+				Samples: []Sample{{StackID: 1}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "frame out of bounds",
+			trace: Trace{
+				Frames:  []frame.Frame{{Function: "a"}},
+				Stacks:  []Stack{{0, 1}},
+				Samples: []Sample{{StackID: 0}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unordered timestamps",
+			trace: Trace{
+				Frames:  []frame.Frame{{Function: "a"}},
+				Stacks:  []Stack{{0}},
+				Samples: []Sample{{ElapsedSinceStartNS: 20}, {ElapsedSinceStartNS: 10}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := Profile{RawProfile: RawProfile{Trace: test.trace}}
+			err := p.Validate(limits)
+			if !test.wantErr {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected a validation error, got %v", err)
+			}
+		})
+	}
+}
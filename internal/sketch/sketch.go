@@ -0,0 +1,218 @@
+package sketch
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"sort"
+)
+
+type (
+	// DDSketch is a mergeable quantile sketch with a relative accuracy
+	// guarantee: any quantile returned is within RelativeAccuracy of the
+	// exact value. Its size only depends on the range of the values added.
+	DDSketch struct {
+		relativeAccuracy float64
+		gamma            float64
+		logGamma         float64
+
+		bins      map[int32]uint64
+		count     uint64
+		max       float64
+		min       float64
+		sum       float64
+		zeroCount uint64
+	}
+
+	// Serialized is the representation of a sketch exchanged with other
+	// services, the counts of the bins are ordered by index.
+	Serialized struct {
+		RelativeAccuracy float64  `json:"relative_accuracy"`
+		Count            uint64   `json:"count"`
+		Counts           []uint64 `json:"counts"`
+		Indexes          []int32  `json:"indexes"`
+		Max              float64  `json:"max"`
+		Min              float64  `json:"min"`
+		Sum              float64  `json:"sum"`
+		ZeroCount        uint64   `json:"zero_count"`
+	}
+)
+
+const (
+	DefaultRelativeAccuracy = 0.01
+
+	// minIndexableValue is the smallest value stored in a bin, smaller values
+	// are counted as zeros.
+	minIndexableValue = 1e-9
+)
+
+var (
+	ErrEmptySketch          = errors.New("sketch: empty sketch")
+	ErrInvalidAccuracy      = errors.New("sketch: relative accuracy must be between 0 and 1")
+	ErrInvalidQuantile      = errors.New("sketch: quantile must be between 0 and 1")
+	ErrIncompatibleSketch   = errors.New("sketch: sketches have different relative accuracies")
+	ErrInvalidSerialization = errors.New("sketch: indexes and counts have different lengths")
+)
+
+func New(relativeAccuracy float64) (*DDSketch, error) {
+	if relativeAccuracy <= 0 || relativeAccuracy >= 1 {
+		return nil, ErrInvalidAccuracy
+	}
+	gamma := (1 + relativeAccuracy) / (1 - relativeAccuracy)
+	return &DDSketch{
+		relativeAccuracy: relativeAccuracy,
+		gamma:            gamma,
+		logGamma:         math.Log(gamma),
+		bins:             make(map[int32]uint64),
+	}, nil
+}
+
+// NewDefault returns a sketch with a 1% relative accuracy.
+func NewDefault() *DDSketch {
+	s, _ := New(DefaultRelativeAccuracy)
+	return s
+}
+
+func (s *DDSketch) index(v float64) int32 {
+	return int32(math.Ceil(math.Log(v) / s.logGamma))
+}
+
+func (s *DDSketch) value(index int32) float64 {
+	return 2 * math.Pow(s.gamma, float64(index)) / (1 + s.gamma)
+}
+
+// Add adds a non-negative value to the sketch.
+func (s *DDSketch) Add(v float64) {
+	if v < 0 {
+		v = 0
+	}
+	if s.count == 0 || v < s.min {
+		s.min = v
+	}
+	if s.count == 0 || v > s.max {
+		s.max = v
+	}
+	s.count++
+	s.sum += v
+	if v < minIndexableValue {
+		s.zeroCount++
+		return
+	}
+	s.bins[s.index(v)]++
+}
+
+func (s *DDSketch) Count() uint64 {
+	return s.count
+}
+
+func (s *DDSketch) Sum() float64 {
+	return s.sum
+}
+
+// Quantile returns the value at the quantile q, using the same rank as
+// picking the value at index ceil(q*count)-1 in the sorted values.
+func (s *DDSketch) Quantile(q float64) (float64, error) {
+	if q <= 0 || q > 1 {
+		return 0, ErrInvalidQuantile
+	}
+	if s.count == 0 {
+		return 0, ErrEmptySketch
+	}
+	rank := uint64(math.Ceil(q*float64(s.count))) - 1
+	if rank < s.zeroCount {
+		return s.min, nil
+	}
+	cumulative := s.zeroCount
+	indexes := s.sortedIndexes()
+	v := s.max
+	for _, i := range indexes {
+		cumulative += s.bins[i]
+		if cumulative > rank {
+			v = s.value(i)
+			break
+		}
+	}
+	// The exact extremes are known, keep the estimate within them.
+	return math.Max(s.min, math.Min(s.max, v)), nil
+}
+
+func (s *DDSketch) sortedIndexes() []int32 {
+	indexes := make([]int32, 0, len(s.bins))
+	for i := range s.bins {
+		indexes = append(indexes, i)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i] < indexes[j]
+	})
+	return indexes
+}
+
+// Merge adds the values of another sketch with the same relative accuracy.
+func (s *DDSketch) Merge(o *DDSketch) error {
+	if s.relativeAccuracy != o.relativeAccuracy {
+		return ErrIncompatibleSketch
+	}
+	if o.count == 0 {
+		return nil
+	}
+	if s.count == 0 || o.min < s.min {
+		s.min = o.min
+	}
+	if s.count == 0 || o.max > s.max {
+		s.max = o.max
+	}
+	s.count += o.count
+	s.sum += o.sum
+	s.zeroCount += o.zeroCount
+	for i, c := range o.bins {
+		s.bins[i] += c
+	}
+	return nil
+}
+
+func (s DDSketch) Serialize() Serialized {
+	indexes := s.sortedIndexes()
+	counts := make([]uint64, 0, len(indexes))
+	for _, i := range indexes {
+		counts = append(counts, s.bins[i])
+	}
+	return Serialized{
+		RelativeAccuracy: s.relativeAccuracy,
+		Count:            s.count,
+		Counts:           counts,
+		Indexes:          indexes,
+		Max:              s.max,
+		Min:              s.min,
+		Sum:              s.sum,
+		ZeroCount:        s.zeroCount,
+	}
+}
+
+func (s DDSketch) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Serialize())
+}
+
+func (s *DDSketch) UnmarshalJSON(b []byte) error {
+	var ss Serialized
+	err := json.Unmarshal(b, &ss)
+	if err != nil {
+		return err
+	}
+	if len(ss.Indexes) != len(ss.Counts) {
+		return ErrInvalidSerialization
+	}
+	d, err := New(ss.RelativeAccuracy)
+	if err != nil {
+		return err
+	}
+	d.count = ss.Count
+	d.max = ss.Max
+	d.min = ss.Min
+	d.sum = ss.Sum
+	d.zeroCount = ss.ZeroCount
+	for i, index := range ss.Indexes {
+		d.bins[index] += ss.Counts[i]
+	}
+	*s = *d
+	return nil
+}
@@ -0,0 +1,108 @@
+package sketch
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"testing"
+)
+
+func exactQuantile(values []float64, q float64) float64 {
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+	return sorted[int(math.Ceil(float64(len(sorted))*q))-1]
+}
+
+func TestQuantile(t *testing.T) {
+	values := make([]float64, 0, 10000)
+	for i := 1; i <= 10000; i++ {
+		// Spread values over several orders of magnitude.
+		values = append(values, math.Pow(1.001, float64(i))*1000)
+	}
+	s := NewDefault()
+	for _, v := range values {
+		s.Add(v)
+	}
+	for _, q := range []float64{0.01, 0.25, 0.5, 0.75, 0.9, 0.95, 0.99, 0.999, 1} {
+		got, err := s.Quantile(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := exactQuantile(values, q)
+		if math.Abs(got-want)/want > DefaultRelativeAccuracy {
+			t.Fatalf("quantile %v: got %v want %v", q, got, want)
+		}
+	}
+}
+
+func TestQuantileErrors(t *testing.T) {
+	s := NewDefault()
+	if _, err := s.Quantile(0.5); err != ErrEmptySketch {
+		t.Fatalf("expected an empty sketch error, got %v", err)
+	}
+	s.Add(10)
+	for _, q := range []float64{0, -1, 1.5} {
+		if _, err := s.Quantile(q); err != ErrInvalidQuantile {
+			t.Fatalf("expected an invalid quantile error for %v, got %v", q, err)
+		}
+	}
+}
+
+func TestZeros(t *testing.T) {
+	s := NewDefault()
+	for _, v := range []float64{0, 0, 0, 100} {
+		s.Add(v)
+	}
+	if got, _ := s.Quantile(0.5); got != 0 {
+		t.Fatalf("expected 0, got %v", got)
+	}
+	if got, _ := s.Quantile(1); got != 100 {
+		t.Fatalf("expected 100, got %v", got)
+	}
+}
+
+func TestMergeAndSerialize(t *testing.T) {
+	a := NewDefault()
+	b := NewDefault()
+	all := NewDefault()
+	for i := 1; i <= 1000; i++ {
+		v := float64(i * 1000)
+		if i%2 == 0 {
+			a.Add(v)
+		} else {
+			b.Add(v)
+		}
+		all.Add(v)
+	}
+
+	encoded, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded DDSketch
+	err = json.Unmarshal(encoded, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = a.Merge(&decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.Count() != all.Count() || a.Sum() != all.Sum() {
+		t.Fatalf("merged sketch mismatch: got count=%v sum=%v want count=%v sum=%v", a.Count(), a.Sum(), all.Count(), all.Sum())
+	}
+	for _, q := range []float64{0.5, 0.95, 0.99} {
+		got, _ := a.Quantile(q)
+		want, _ := all.Quantile(q)
+		if got != want {
+			t.Fatalf("quantile %v: got %v want %v", q, got, want)
+		}
+	}
+
+	other, _ := New(0.05)
+	if err := a.Merge(other); err != ErrIncompatibleSketch {
+		t.Fatalf("expected an incompatible sketch error, got %v", err)
+	}
+}
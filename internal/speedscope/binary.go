@@ -0,0 +1,83 @@
+package speedscope
+
+import (
+	"encoding/json"
+
+	"github.com/getsentry/vroom/pkg/flamegraphbin"
+)
+
+// Flamegraph converts an aggregated flamegraph to its binary representation.
+// Only sampled profiles are kept, aggregation doesn't produce other ones.
+func (o Output) Flamegraph() (*flamegraphbin.Flamegraph, error) {
+	f := &flamegraphbin.Flamegraph{
+		ProjectID:  o.Metadata.ProjectID,
+		Frames:     make([]flamegraphbin.Frame, 0, len(o.Shared.Frames)),
+		ProfileIDs: o.Shared.ProfileIDs,
+		Examples:   make([]flamegraphbin.Example, 0, len(o.Shared.Profiles)),
+		Profiles:   make([]flamegraphbin.Profile, 0, len(o.Profiles)),
+	}
+	for _, fr := range o.Shared.Frames {
+		f.Frames = append(f.Frames, flamegraphbin.Frame{
+			Name:          fr.Name,
+			File:          fr.File,
+			Path:          fr.Path,
+			Image:         fr.Image,
+			Line:          fr.Line,
+			Col:           fr.Col,
+			IsApplication: fr.IsApplication,
+			Inline:        fr.Inline,
+		})
+	}
+	for _, ex := range o.Shared.Profiles {
+		f.Examples = append(f.Examples, flamegraphbin.Example{
+			ProjectID:     ex.ProjectID,
+			ProfileID:     ex.ProfileID,
+			ProfilerID:    ex.ProfilerID,
+			ChunkID:       ex.ChunkID,
+			TransactionID: ex.TransactionID,
+			ThreadID:      ex.ThreadID,
+			Start:         ex.Start,
+			End:           ex.End,
+		})
+	}
+	for _, p := range o.Profiles {
+		var sp SampledProfile
+		switch p := p.(type) {
+		case SampledProfile:
+			sp = p
+		case *SampledProfile:
+			sp = *p
+		default:
+			continue
+		}
+		f.Profiles = append(f.Profiles, flamegraphbin.Profile{
+			Name:              sp.Name,
+			Unit:              string(sp.Unit),
+			ThreadID:          sp.ThreadID,
+			IsMainThread:      sp.IsMainThread,
+			StartValue:        sp.StartValue,
+			EndValue:          sp.EndValue,
+			Samples:           sp.Samples,
+			Weights:           sp.Weights,
+			SampleDurationsNS: sp.SampleDurationsNs,
+			SampleCounts:      sp.SampleCounts,
+			SamplesProfiles:   sp.SamplesProfiles,
+			SamplesExamples:   sp.SamplesExamples,
+		})
+	}
+	if o.Truncation != nil {
+		f.Truncation = &flamegraphbin.Truncation{
+			PrunedSamples:   o.Truncation.PrunedSamples,
+			PrunedFrames:    o.Truncation.PrunedFrames,
+			TruncatedFrames: o.Truncation.TruncatedFrames,
+		}
+	}
+	if o.Metrics != nil {
+		b, err := json.Marshal(o.Metrics)
+		if err != nil {
+			return nil, err
+		}
+		f.Metrics = b
+	}
+	return f, nil
+}
@@ -0,0 +1,241 @@
+package speedscope
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/getsentry/vroom/internal/utils"
+	"github.com/getsentry/vroom/pkg/vroompb"
+)
+
+// Proto converts a profile to its gRPC representation.
+func (o Output) Proto() (*vroompb.Speedscope, error) {
+	pb := &vroompb.Speedscope{
+		ActiveProfileIndex: int32(o.ActiveProfileIndex),
+		AndroidClock:       o.AndroidClock,
+		DurationNs:         o.DurationNS,
+		Platform:           string(o.Platform),
+		ProfileId:          o.ProfileID,
+		ProjectId:          o.ProjectID,
+		TransactionName:    o.TransactionName,
+		Version:            o.Version,
+		Metadata:           o.Metadata.proto(),
+		Frames:             framesProto(o.Shared.Frames),
+		Profiles:           make([]*vroompb.SpeedscopeProfile, 0, len(o.Profiles)),
+	}
+	for _, p := range o.Profiles {
+		switch p := p.(type) {
+		case SampledProfile:
+			pb.Profiles = append(pb.Profiles, p.sampledProto(0, len(p.Samples)))
+		case *SampledProfile:
+			pb.Profiles = append(pb.Profiles, p.sampledProto(0, len(p.Samples)))
+		case EventedProfile:
+			pb.Profiles = append(pb.Profiles, p.proto())
+		case *EventedProfile:
+			pb.Profiles = append(pb.Profiles, p.proto())
+		}
+	}
+	if o.Metrics != nil {
+		pb.Metrics = utils.FunctionMetricsProto(*o.Metrics)
+	}
+	if len(o.Measurements) > 0 {
+		b, err := json.Marshal(o.Measurements)
+		if err != nil {
+			return nil, err
+		}
+		pb.Measurements = b
+	}
+	return pb, nil
+}
+
+// StreamFlamegraph sends an aggregated flamegraph as a header followed by the
+// samples of each profile, split in parts of at most samplesPerPart samples.
+// Only sampled profiles are kept, aggregation doesn't produce other ones.
+func (o Output) StreamFlamegraph(samplesPerPart int, send func(*vroompb.FlamegraphPart) error) error {
+	header := &vroompb.FlamegraphHeader{
+		ProjectId:  o.Metadata.ProjectID,
+		Frames:     framesProto(o.Shared.Frames),
+		ProfileIds: o.Shared.ProfileIDs,
+		Examples:   make([]*vroompb.Example, 0, len(o.Shared.Profiles)),
+	}
+	for _, ex := range o.Shared.Profiles {
+		header.Examples = append(header.Examples, ex.Proto())
+	}
+	if o.Metrics != nil {
+		header.Metrics = utils.FunctionMetricsProto(*o.Metrics)
+	}
+	if o.Truncation != nil {
+		header.Truncation = &vroompb.Truncation{
+			PrunedSamples:   o.Truncation.PrunedSamples,
+			PrunedFrames:    o.Truncation.PrunedFrames,
+			TruncatedFrames: o.Truncation.TruncatedFrames,
+		}
+	}
+	err := send(&vroompb.FlamegraphPart{
+		Part: &vroompb.FlamegraphPart_Header{Header: header},
+	})
+	if err != nil {
+		return err
+	}
+
+	index := uint32(0)
+	for _, p := range o.Profiles {
+		var sp SampledProfile
+		switch p := p.(type) {
+		case SampledProfile:
+			sp = p
+		case *SampledProfile:
+			sp = *p
+		default:
+			continue
+		}
+		// An empty profile is still sent so the indices match the header.
+		for start := 0; start == 0 || start < len(sp.Samples); start += samplesPerPart {
+			end := min(start+samplesPerPart, len(sp.Samples))
+			err := send(&vroompb.FlamegraphPart{
+				Part: &vroompb.FlamegraphPart_Samples{
+					Samples: &vroompb.FlamegraphSamples{
+						ProfileIndex: index,
+						Profile:      sp.sampledProto(start, end).GetSampled(),
+					},
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		index++
+	}
+	return nil
+}
+
+func (m ProfileMetadata) proto() *vroompb.ProfileMetadata {
+	pb := &vroompb.ProfileMetadata{
+		Sampled:              m.Sampled,
+		AndroidApiLevel:      m.AndroidAPILevel,
+		Architecture:         m.Architecture,
+		DeviceClassification: m.DeviceClassification,
+		DeviceLocale:         m.DeviceLocale,
+		DeviceManufacturer:   m.DeviceManufacturer,
+		DeviceModel:          m.DeviceModel,
+		DeviceOsBuildNumber:  m.DeviceOSBuildNumber,
+		DeviceOsName:         m.DeviceOSName,
+		DeviceOsVersion:      m.DeviceOSVersion,
+		DurationNs:           m.DurationNS,
+		Environment:          m.Environment,
+		OrganizationId:       m.OrganizationID,
+		Platform:             string(m.Platform),
+		ProfileId:            m.ProfileID,
+		ProjectId:            m.ProjectID,
+		TraceId:              m.TraceID,
+		TransactionId:        m.TransactionID,
+		TransactionName:      m.TransactionName,
+		Version:              m.Version,
+	}
+	if received := m.Received.Time(); !received.IsZero() {
+		pb.Received = timestamppb.New(received)
+	}
+	if !m.Timestamp.IsZero() {
+		pb.Timestamp = timestamppb.New(m.Timestamp)
+	}
+	return pb
+}
+
+func framesProto(frames []Frame) []*vroompb.Frame {
+	pb := make([]*vroompb.Frame, 0, len(frames))
+	for _, fr := range frames {
+		pb = append(pb, &vroompb.Frame{
+			Name:          fr.Name,
+			File:          fr.File,
+			Path:          fr.Path,
+			Image:         fr.Image,
+			Line:          fr.Line,
+			Col:           fr.Col,
+			IsApplication: fr.IsApplication,
+			Inline:        fr.Inline,
+		})
+	}
+	return pb
+}
+
+// sampledProto converts the samples in [start, end) of the profile. The
+// columns are sliced the same way when they have a value for each sample.
+func (p SampledProfile) sampledProto(start, end int) *vroompb.SpeedscopeProfile {
+	pb := &vroompb.SampledProfile{
+		Name:              p.Name,
+		Unit:              string(p.Unit),
+		ThreadId:          p.ThreadID,
+		IsMainThread:      p.IsMainThread,
+		StartValue:        p.StartValue,
+		EndValue:          p.EndValue,
+		Priority:          int32(p.Priority),
+		State:             p.State,
+		Samples:           indicesProto(p.Samples[start:end]),
+		Weights:           sliceColumn(p.Weights, len(p.Samples), start, end),
+		SampleDurationsNs: sliceColumn(p.SampleDurationsNs, len(p.Samples), start, end),
+		SampleCounts:      sliceColumn(p.SampleCounts, len(p.Samples), start, end),
+		SamplesProfiles:   indicesProto(sliceColumn(p.SamplesProfiles, len(p.Samples), start, end)),
+		SamplesExamples:   indicesProto(sliceColumn(p.SamplesExamples, len(p.Samples), start, end)),
+	}
+	if len(p.Queues) > 0 {
+		pb.Queues = make(map[string]*vroompb.Queue, len(p.Queues))
+		for k, q := range p.Queues {
+			pb.Queues[k] = &vroompb.Queue{Name: q.Label, StartNs: q.StartNS, EndNs: q.EndNS}
+		}
+	}
+	return &vroompb.SpeedscopeProfile{
+		Profile: &vroompb.SpeedscopeProfile_Sampled{Sampled: pb},
+	}
+}
+
+func (p EventedProfile) proto() *vroompb.SpeedscopeProfile {
+	pb := &vroompb.EventedProfile{
+		Name:       p.Name,
+		Unit:       string(p.Unit),
+		ThreadId:   p.ThreadID,
+		StartValue: p.StartValue,
+		EndValue:   p.EndValue,
+		Events:     make([]*vroompb.Event, 0, len(p.Events)),
+	}
+	for _, e := range p.Events {
+		event := &vroompb.Event{Frame: int32(e.Frame), At: e.At}
+		switch e.Type {
+		case EventTypeOpenFrame:
+			event.Type = vroompb.Event_TYPE_OPEN_FRAME
+		case EventTypeCloseFrame:
+			event.Type = vroompb.Event_TYPE_CLOSE_FRAME
+		}
+		pb.Events = append(pb.Events, event)
+	}
+	return &vroompb.SpeedscopeProfile{
+		Profile: &vroompb.SpeedscopeProfile_Evented{Evented: pb},
+	}
+}
+
+// sliceColumn returns the values of a column for the samples in [start, end)
+// or the whole column when it doesn't have a value for each sample.
+func sliceColumn[T any](column []T, numSamples, start, end int) []T {
+	if len(column) != numSamples {
+		if start > 0 {
+			return nil
+		}
+		return column
+	}
+	return column[start:end]
+}
+
+func indicesProto(lists [][]int) []*vroompb.Indices {
+	if lists == nil {
+		return nil
+	}
+	pb := make([]*vroompb.Indices, 0, len(lists))
+	for _, l := range lists {
+		values := make([]int32, 0, len(l))
+		for _, v := range l {
+			values = append(values, int32(v))
+		}
+		pb = append(pb, &vroompb.Indices{Values: values})
+	}
+	return pb
+}
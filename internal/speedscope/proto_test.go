@@ -0,0 +1,69 @@
+package speedscope
+
+import (
+	"testing"
+
+	"github.com/getsentry/vroom/internal/testutil"
+	"github.com/getsentry/vroom/pkg/vroompb"
+)
+
+func TestStreamFlamegraph(t *testing.T) {
+	output := Output{
+		Profiles: []interface{}{
+			SampledProfile{
+				Samples:         [][]int{{0}, {0, 1}, {1}},
+				Weights:         []uint64{1, 2, 3},
+				SampleCounts:    []uint64{1, 2, 3},
+				SamplesProfiles: [][]int{{0}, {0}, {0}},
+			},
+			EventedProfile{Events: []Event{{Type: EventTypeOpenFrame}}},
+			&SampledProfile{},
+		},
+		Shared: SharedData{
+			Frames:     []Frame{{Name: "a"}, {Name: "b"}},
+			ProfileIDs: []string{"abc"},
+		},
+	}
+
+	var parts []*vroompb.FlamegraphPart
+	err := output.StreamFlamegraph(2, func(p *vroompb.FlamegraphPart) error {
+		parts = append(parts, p)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(parts) != 4 {
+		t.Fatalf("expected 4 parts, got %d", len(parts))
+	}
+	header := parts[0].GetHeader()
+	if header == nil || len(header.GetFrames()) != 2 || len(header.GetProfileIds()) != 1 {
+		t.Fatalf("unexpected header: %v", parts[0])
+	}
+
+	type samples struct {
+		ProfileIndex uint32
+		Samples      int
+		Weights      []uint64
+		SampleCounts []uint64
+	}
+	var got []samples
+	for _, p := range parts[1:] {
+		s := p.GetSamples()
+		got = append(got, samples{
+			ProfileIndex: s.GetProfileIndex(),
+			Samples:      len(s.GetProfile().GetSamples()),
+			Weights:      s.GetProfile().GetWeights(),
+			SampleCounts: s.GetProfile().GetSampleCounts(),
+		})
+	}
+	want := []samples{
+		{ProfileIndex: 0, Samples: 2, Weights: []uint64{1, 2}, SampleCounts: []uint64{1, 2}},
+		{ProfileIndex: 0, Samples: 1, Weights: []uint64{3}, SampleCounts: []uint64{3}},
+		{ProfileIndex: 1, Samples: 0},
+	}
+	if diff := testutil.Diff(got, want); diff != "" {
+		t.Fatalf("Result mismatch: got - want +\n%s", diff)
+	}
+}
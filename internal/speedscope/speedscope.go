@@ -101,6 +101,19 @@ type (
 		TransactionName    string                              `json:"transactionName"`
 		Version            string                              `json:"version,omitempty"`
 		Metrics            *[]utils.FunctionMetrics            `json:"metrics"`
+		Truncation         *Truncation                         `json:"truncation,omitempty"`
+	}
+
+	// Truncation reports what was left out of an aggregated flamegraph.
+	Truncation struct {
+		// PrunedSamples is the number of samples dropped for being under
+		// the minimum sample count or duration.
+		PrunedSamples uint64 `json:"pruned_samples"`
+		// PrunedFrames is the number of frames dropped with them.
+		PrunedFrames uint64 `json:"pruned_frames"`
+		// TruncatedFrames is the number of frames deeper than the maximum
+		// depth. Their samples are attributed to their ancestor.
+		TruncatedFrames uint64 `json:"truncated_frames"`
 	}
 
 	ProfileMetadata struct {
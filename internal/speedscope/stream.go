@@ -0,0 +1,124 @@
+package speedscope
+
+import (
+	"github.com/getsentry/vroom/internal/jsonutil"
+)
+
+// WriteJSON streams the output in the same format as encoding/json, writing
+// frames, samples and weights one at a time. It has to be kept in sync with
+// the fields of Output.
+func (o Output) WriteJSON(sw *jsonutil.StreamWriter) {
+	sw.BeginObject()
+	sw.Field("activeProfileIndex", o.ActiveProfileIndex)
+	if o.AndroidClock != "" {
+		sw.Field("androidClock", o.AndroidClock)
+	}
+	if o.DurationNS != 0 {
+		sw.Field("durationNS", o.DurationNS)
+	}
+	if len(o.Images) != 0 {
+		sw.Field("images", o.Images)
+	}
+	if len(o.Measurements) != 0 {
+		sw.Field("measurements", o.Measurements)
+	}
+	sw.Field("metadata", o.Metadata)
+	sw.Field("platform", o.Platform)
+	if o.ProfileID != "" {
+		sw.Field("profileID", o.ProfileID)
+	}
+	sw.Key("profiles")
+	if o.Profiles == nil {
+		sw.Null()
+	} else {
+		sw.BeginArray()
+		for _, p := range o.Profiles {
+			switch p := p.(type) {
+			case SampledProfile:
+				p.WriteJSON(sw)
+			case *SampledProfile:
+				p.WriteJSON(sw)
+			default:
+				sw.Value(p)
+			}
+		}
+		sw.EndArray()
+	}
+	sw.Field("projectID", o.ProjectID)
+	sw.Key("shared")
+	o.Shared.WriteJSON(sw)
+	sw.Field("transactionName", o.TransactionName)
+	if o.Version != "" {
+		sw.Field("version", o.Version)
+	}
+	sw.Field("metrics", o.Metrics)
+	if o.Truncation != nil {
+		sw.Field("truncation", o.Truncation)
+	}
+	sw.EndObject()
+}
+
+func (p SampledProfile) WriteJSON(sw *jsonutil.StreamWriter) {
+	sw.BeginObject()
+	sw.Field("endValue", p.EndValue)
+	sw.Field("isMainThread", p.IsMainThread)
+	sw.Field("name", p.Name)
+	if p.Priority != 0 {
+		sw.Field("priority", p.Priority)
+	}
+	if len(p.Queues) != 0 {
+		sw.Field("queues", p.Queues)
+	}
+	sw.Key("samples")
+	sw.IntSlices(p.Samples)
+	if len(p.SamplesProfiles) != 0 {
+		sw.Key("samples_profiles")
+		sw.IntSlices(p.SamplesProfiles)
+	}
+	if len(p.SamplesExamples) != 0 {
+		sw.Key("samples_examples")
+		sw.IntSlices(p.SamplesExamples)
+	}
+	sw.Field("startValue", p.StartValue)
+	if p.State != "" {
+		sw.Field("state", p.State)
+	}
+	sw.Field("threadID", p.ThreadID)
+	sw.Field("type", p.Type)
+	sw.Field("unit", p.Unit)
+	sw.Key("weights")
+	sw.Uint64s(p.Weights)
+	sw.Key("sample_durations_ns")
+	sw.Uint64s(p.SampleDurationsNs)
+	if len(p.SampleCounts) != 0 {
+		sw.Key("sample_counts")
+		sw.Uint64s(p.SampleCounts)
+	}
+	sw.EndObject()
+}
+
+func (s SharedData) WriteJSON(sw *jsonutil.StreamWriter) {
+	sw.BeginObject()
+	sw.Key("frames")
+	if s.Frames == nil {
+		sw.Null()
+	} else {
+		sw.BeginArray()
+		for _, f := range s.Frames {
+			sw.Value(f)
+		}
+		sw.EndArray()
+	}
+	if len(s.ProfileIDs) != 0 {
+		sw.Field("profile_ids", s.ProfileIDs)
+	}
+	if len(s.Profiles) != 0 {
+		sw.Key("profiles")
+		sw.BeginArray()
+		for _, p := range s.Profiles {
+			sw.Value(p)
+		}
+		sw.EndArray()
+	}
+	sw.EndObject()
+}
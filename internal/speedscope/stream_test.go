@@ -0,0 +1,83 @@
+package speedscope
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/getsentry/vroom/internal/jsonutil"
+	"github.com/getsentry/vroom/internal/platform"
+	"github.com/getsentry/vroom/internal/utils"
+)
+
+func TestOutputWriteJSON(t *testing.T) {
+	threadID := "1"
+	tests := []struct {
+		name   string
+		output Output
+	}{
+		{
+			name:   "empty",
+			output: Output{},
+		},
+		{
+			name: "aggregated flamegraph",
+			output: Output{
+				Platform: platform.Python,
+				Profiles: []interface{}{
+					SampledProfile{
+						IsMainThread:      true,
+						Name:              "main",
+						Samples:           [][]int{{0, 1}, {0, 2}},
+						SamplesProfiles:   [][]int{{0}, {}},
+						SamplesExamples:   [][]int{{}, {0}},
+						ThreadID:          1,
+						Type:              ProfileTypeSampled,
+						Unit:              ValueUnitCount,
+						Weights:           []uint64{3, 4},
+						SampleDurationsNs: []uint64{30, 40},
+						SampleCounts:      []uint64{3, 4},
+					},
+					&SampledProfile{
+						Name:     "worker",
+						Priority: 2,
+						Queues:   map[string]Queue{"q": {Label: "q", StartNS: 1, EndNS: 2}},
+						State:    "running",
+					},
+					EventedProfile{
+						Events: []Event{{Type: EventTypeOpenFrame, Frame: 0, At: 1}},
+						Type:   ProfileTypeEvented,
+					},
+				},
+				ProjectID: 1,
+				Shared: SharedData{
+					Frames:     []Frame{{Name: "a", IsApplication: true}, {Name: "<b>", Path: "b.py"}},
+					ProfileIDs: []string{"abc"},
+					Profiles:   []utils.ExampleMetadata{{ProfilerID: "p", ThreadID: &threadID, Start: 1.5}},
+				},
+				TransactionName: "t",
+				Metrics:         &[]utils.FunctionMetrics{{Name: "a"}},
+				Truncation:      &Truncation{PrunedSamples: 1},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expected, err := json.Marshal(test.output)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var b bytes.Buffer
+			sw := jsonutil.NewStreamWriter(context.Background(), &b)
+			test.output.WriteJSON(sw)
+			if err := sw.Flush(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !bytes.Equal(expected, b.Bytes()) {
+				t.Fatalf("expected %s, got %s", expected, b.Bytes())
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package storageutil
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/getsentry/vroom/internal/telemetry"
+)
+
+type (
+	Priority int
+
+	// ReadOptions control how the jobs of a request are scheduled.
+	ReadOptions struct {
+		Priority Priority
+		// MaxConcurrency caps the number of jobs of a request read at the
+		// same time. Zero means no cap.
+		MaxConcurrency int
+	}
+
+	// Scheduler runs read jobs on a fixed pool of workers. Jobs with a higher
+	// priority are read first and the jobs of a request don't use more
+	// workers than its cap, leaving room for other requests.
+	Scheduler struct {
+		mu   sync.Mutex
+		cond *sync.Cond
+		// ready holds, for each priority, the requests with jobs waiting
+		// and below their cap in submission order. Requests at their cap
+		// are left out until one of their jobs is done.
+		ready    [numPriorities]*list.List
+		queued   int
+		capacity int
+		workers  int
+		closed   bool
+		wg       sync.WaitGroup
+	}
+
+	// batch holds the jobs submitted together by a request.
+	batch struct {
+		jobs           []ReadJob
+		priority       Priority
+		running        int
+		maxConcurrency int
+		// element is the position of the batch in its ready list, nil
+		// when it isn't in it.
+		element *list.Element
+	}
+
+	readOptionsKey struct{}
+)
+
+const (
+	PriorityLow Priority = iota
+	PriorityNormal
+	PriorityHigh
+
+	numPriorities = 3
+)
+
+var (
+	// ErrSchedulerSaturated is returned when the queue can't hold the jobs.
+	ErrSchedulerSaturated = errors.New("read scheduler saturated")
+	// ErrTooManyReadJobs is returned when a request submits more jobs than
+	// the queue could ever hold.
+	ErrTooManyReadJobs = errors.New("too many objects to read")
+)
+
+// NewScheduler starts the workers. capacity is the maximum number of jobs
+// waiting for a worker.
+func NewScheduler(workers, capacity int) *Scheduler {
+	s := &Scheduler{capacity: capacity, workers: workers}
+	s.cond = sync.NewCond(&s.mu)
+	for p := range s.ready {
+		s.ready[p] = list.New()
+	}
+	s.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go s.work()
+	}
+	return s
+}
+
+// WithReadOptions returns a context scheduling the jobs submitted with it
+// according to the options.
+func WithReadOptions(ctx context.Context, options ReadOptions) context.Context {
+	return context.WithValue(ctx, readOptionsKey{}, options)
+}
+
+// ReadOptionsFromContext returns the options the jobs submitted with the
+// context are scheduled with.
+func ReadOptionsFromContext(ctx context.Context) ReadOptions {
+	if options, ok := ctx.Value(readOptionsKey{}).(ReadOptions); ok {
+		return options
+	}
+	return ReadOptions{Priority: PriorityNormal}
+}
+
+// Submit queues the jobs of a request with the options of its context. No
+// job is queued if they don't all fit, ErrTooManyReadJobs is returned if
+// they never would.
+func (s *Scheduler) Submit(ctx context.Context, jobs ...ReadJob) error {
+	if len(jobs) == 0 {
+		return nil
+	}
+	if len(jobs) > s.capacity {
+		return ErrTooManyReadJobs
+	}
+	options := ReadOptionsFromContext(ctx)
+	priority := options.Priority
+	if priority < PriorityLow {
+		priority = PriorityLow
+	} else if priority > PriorityHigh {
+		priority = PriorityHigh
+	}
+	b := &batch{
+		jobs:           append([]ReadJob(nil), jobs...),
+		priority:       priority,
+		maxConcurrency: options.MaxConcurrency,
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed || s.queued+len(jobs) > s.capacity {
+		return ErrSchedulerSaturated
+	}
+	b.element = s.ready[priority].PushBack(b)
+	s.queued += len(jobs)
+	// Wake up as many workers as the request can use.
+	wake := len(jobs)
+	if b.maxConcurrency > 0 && b.maxConcurrency < wake {
+		wake = b.maxConcurrency
+	}
+	if s.workers < wake {
+		wake = s.workers
+	}
+	for i := 0; i < wake; i++ {
+		s.cond.Signal()
+	}
+	return nil
+}
+
+// Len returns the number of jobs waiting for a worker.
+func (s *Scheduler) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.queued
+}
+
+// Close waits for the queued jobs to be processed and stops the workers.
+func (s *Scheduler) Close() {
+	s.mu.Lock()
+	s.closed = true
+	s.cond.Broadcast()
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+// next returns the first job of the first request of the highest priority
+// below its cap. It has to be called with the lock held.
+func (s *Scheduler) next() (ReadJob, *batch, bool) {
+	for p := numPriorities - 1; p >= 0; p-- {
+		front := s.ready[p].Front()
+		if front == nil {
+			continue
+		}
+		b := front.Value.(*batch)
+		job := b.jobs[0]
+		b.jobs[0] = nil
+		b.jobs = b.jobs[1:]
+		b.running++
+		s.queued--
+		if len(b.jobs) == 0 || b.capped() {
+			s.ready[p].Remove(front)
+			b.element = nil
+		}
+		return job, b, true
+	}
+	return nil, nil, false
+}
+
+func (b *batch) capped() bool {
+	return b.maxConcurrency > 0 && b.running >= b.maxConcurrency
+}
+
+func (s *Scheduler) work() {
+	defer s.wg.Done()
+	for {
+		s.mu.Lock()
+		job, b, ok := s.next()
+		for !ok {
+			if s.closed && s.queued == 0 {
+				s.mu.Unlock()
+				return
+			}
+			s.cond.Wait()
+			job, b, ok = s.next()
+		}
+		s.mu.Unlock()
+
+		// Don't read for requests already gone.
+		if err := job.Context().Err(); err != nil {
+			job.Skip(err)
+		} else {
+			telemetry.ReadWorkersBusy.Inc()
+			job.Read()
+			telemetry.ReadWorkersBusy.Dec()
+		}
+
+		s.mu.Lock()
+		b.running--
+		if b.element == nil && len(b.jobs) > 0 {
+			// The request was at its cap, it's queued again behind the
+			// other requests of its priority.
+			b.element = s.ready[b.priority].PushBack(b)
+			s.cond.Signal()
+		}
+		if s.closed && s.queued == 0 {
+			s.cond.Broadcast()
+		}
+		s.mu.Unlock()
+	}
+}
@@ -0,0 +1,191 @@
+package storageutil
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+type testJob struct {
+	ctx     context.Context
+	name    string
+	started chan<- string
+	release <-chan struct{}
+	result  chan<- error
+}
+
+func (job testJob) Read() {
+	if job.started != nil {
+		job.started <- job.name
+	}
+	if job.release != nil {
+		<-job.release
+	}
+	job.result <- nil
+}
+
+func (job testJob) Context() context.Context {
+	return job.ctx
+}
+
+func (job testJob) Skip(err error) {
+	job.result <- err
+}
+
+func TestSchedulerPriority(t *testing.T) {
+	s := NewScheduler(1, 10)
+	defer s.Close()
+
+	started := make(chan string, 10)
+	release := make(chan struct{})
+	results := make(chan error, 10)
+
+	// Keep the only worker busy while the other jobs are queued.
+	blocker := testJob{ctx: context.Background(), name: "blocker", started: started, release: release, result: results}
+	if err := s.Submit(context.Background(), blocker); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	<-started
+
+	for _, job := range []struct {
+		name     string
+		priority Priority
+	}{
+		{"low", PriorityLow},
+		{"normal", PriorityNormal},
+		{"high", PriorityHigh},
+	} {
+		ctx := WithReadOptions(context.Background(), ReadOptions{Priority: job.priority})
+		err := s.Submit(ctx, testJob{ctx: ctx, name: job.name, started: started, result: results})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	close(release)
+
+	for _, expected := range []string{"high", "normal", "low"} {
+		if name := <-started; name != expected {
+			t.Fatalf("expected %s job to be read, got %s", expected, name)
+		}
+	}
+	for i := 0; i < 4; i++ {
+		if err := <-results; err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+func TestSchedulerMaxConcurrency(t *testing.T) {
+	s := NewScheduler(4, 10)
+	defer s.Close()
+
+	ctx := WithReadOptions(context.Background(), ReadOptions{
+		Priority:       PriorityNormal,
+		MaxConcurrency: 2,
+	})
+	started := make(chan string, 10)
+	release := make(chan struct{})
+	results := make(chan error, 10)
+	jobs := make([]ReadJob, 0, 4)
+	for i := 0; i < 4; i++ {
+		jobs = append(jobs, testJob{ctx: ctx, started: started, release: release, result: results})
+	}
+	if err := s.Submit(ctx, jobs...); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	<-started
+	<-started
+
+	// Other requests still get the idle workers.
+	other := testJob{ctx: context.Background(), name: "other", started: started, result: results}
+	if err := s.Submit(context.Background(), other); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name := <-started; name != "other" {
+		t.Fatalf("expected other job to be read, got a job over the cap")
+	}
+	if err := <-results; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s.Len() != 2 {
+		t.Fatalf("expected 2 jobs queued, got %d", s.Len())
+	}
+
+	close(release)
+	for i := 0; i < 4; i++ {
+		if err := <-results; err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+func TestSchedulerSkipsDoneContext(t *testing.T) {
+	s := NewScheduler(1, 10)
+	defer s.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	started := make(chan string, 1)
+	results := make(chan error, 1)
+	if err := s.Submit(ctx, testJob{ctx: ctx, started: started, result: results}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := <-results; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if len(started) != 0 {
+		t.Fatalf("expected the job not to be read")
+	}
+}
+
+func TestSchedulerSaturated(t *testing.T) {
+	s := NewScheduler(1, 2)
+
+	started := make(chan string, 10)
+	release := make(chan struct{})
+	results := make(chan error, 10)
+	newJob := func() ReadJob {
+		return testJob{ctx: context.Background(), started: started, release: release, result: results}
+	}
+
+	if err := s.Submit(context.Background(), newJob()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	<-started
+
+	if err := s.Submit(context.Background(), newJob(), newJob(), newJob()); !errors.Is(err, ErrTooManyReadJobs) {
+		t.Fatalf("expected ErrTooManyReadJobs, got %v", err)
+	}
+	if err := s.Submit(context.Background(), newJob()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := s.Submit(context.Background(), newJob(), newJob()); !errors.Is(err, ErrSchedulerSaturated) {
+		t.Fatalf("expected ErrSchedulerSaturated, got %v", err)
+	}
+	if s.Len() != 1 {
+		t.Fatalf("expected 1 job queued, got %d", s.Len())
+	}
+	if err := s.Submit(context.Background(), newJob()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	close(release)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.Close()
+	}()
+	for i := 0; i < 3; i++ {
+		if err := <-results; err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	wg.Wait()
+
+	if err := s.Submit(context.Background(), newJob()); !errors.Is(err, ErrSchedulerSaturated) {
+		t.Fatalf("expected ErrSchedulerSaturated after Close, got %v", err)
+	}
+}
@@ -1,23 +1,67 @@
 package storageutil
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/pierrec/lz4/v4"
 	"gocloud.dev/blob"
 	"gocloud.dev/gcerrors"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/getsentry/vroom/internal/telemetry"
 )
 
 // ErrObjectNotFound indicates an object was not found.
 var ErrObjectNotFound = errors.New("object not found")
 
+type countingWriter struct {
+	w io.Writer
+	n int
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += n
+	return n, err
+}
+
+type countingReader struct {
+	r io.Reader
+	n int
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.n += n
+	return n, err
+}
+
+// observeStorage records the bytes transferred and the error of a storage operation.
+func observeStorage(operation string, n int, err error) {
+	telemetry.StorageBytes.WithLabelValues(operation).Add(float64(n))
+	if err == nil {
+		return
+	}
+	code := gcerrors.Code(err)
+	if errors.Is(err, ErrObjectNotFound) {
+		code = gcerrors.NotFound
+	}
+	telemetry.StorageErrors.WithLabelValues(operation, code.String()).Inc()
+}
+
 // CompressedWrite compresses and writes data to Google Cloud Storage.
-func CompressedWrite(ctx context.Context, b *blob.Bucket, objectName string, d interface{}) error {
+func CompressedWrite(ctx context.Context, b *blob.Bucket, objectName string, d interface{}) (err error) {
+	cw := &countingWriter{}
+	defer func() {
+		observeStorage(telemetry.StorageWrite, cw.n, err)
+	}()
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 	writerOptions := &blob.WriterOptions{
@@ -36,7 +80,8 @@ func CompressedWrite(ctx context.Context, b *blob.Bucket, objectName string, d i
 	if err != nil {
 		return err
 	}
-	zw := lz4.NewWriter(ow)
+	cw.w = ow
+	zw := lz4.NewWriter(cw)
 	_ = zw.Apply(lz4.CompressionLevelOption(lz4.Level9))
 	jw := json.NewEncoder(zw)
 	err = jw.Encode(d)
@@ -55,44 +100,88 @@ func CompressedWrite(ctx context.Context, b *blob.Bucket, objectName string, d i
 }
 
 // UnmarshalCompressed reads compressed JSON data from GCS and unmarshals it.
+// Concurrent reads of the same object share a single download. Readers mutate
+// what they decode (samples are sorted when generating call trees for example)
+// so each of them decompresses and decodes its own copy, streaming from the
+// compressed data held in memory.
 func UnmarshalCompressed(
 	ctx context.Context,
 	b *blob.Bucket,
 	objectName string,
 	d interface{},
 ) error {
+	data, err := readCompressed(ctx, b, objectName)
+	if err != nil {
+		return err
+	}
+	return json.NewDecoder(lz4.NewReader(bytes.NewReader(data))).Decode(d)
+}
+
+var (
+	// reads holds the reads in flight, keyed by bucket and object name.
+	reads singleflight.Group
+
+	// readJoined is called once a reader joined a read, tests replace it to
+	// know when readers are waiting.
+	readJoined = func() {}
+)
+
+// readCompressed returns the compressed content of an object, joining an
+// identical read in flight if there's one.
+func readCompressed(ctx context.Context, b *blob.Bucket, objectName string) ([]byte, error) {
+	var fetched bool
+	// The read is shared so it can't be canceled by the request starting it.
+	readCtx := context.WithoutCancel(ctx)
+	ch := reads.DoChan(fmt.Sprintf("%p/%s", b, objectName), func() (interface{}, error) {
+		fetched = true
+		return fetchCompressed(readCtx, b, objectName)
+	})
+	readJoined()
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if !fetched {
+			telemetry.StorageReadsCoalesced.Inc()
+		}
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.([]byte), nil
+	}
+}
+
+func fetchCompressed(ctx context.Context, b *blob.Bucket, objectName string) (data []byte, err error) {
+	cr := &countingReader{}
+	defer func() {
+		observeStorage(telemetry.StorageRead, cr.n, err)
+	}()
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	or, err := b.NewReader(ctx, objectName, nil)
 	if err != nil {
 		if gcerrors.Code(err) == gcerrors.NotFound {
-			return fmt.Errorf("%w: %s", ErrObjectNotFound, objectName)
+			return nil, fmt.Errorf("%w: %s", ErrObjectNotFound, objectName)
 		}
 
-		return err
+		return nil, err
 	}
 	defer or.Close()
-	zr := lz4.NewReader(or)
-	err = json.NewDecoder(zr).Decode(d)
-	if err != nil {
-		return err
-	}
-	return nil
+	cr.r = or
+	return io.ReadAll(cr)
 }
 
 type (
 	ReadJob interface {
 		Read()
+		// Context returns the context of the request the job belongs to.
+		Context() context.Context
+		// Skip sends a result with the error instead of reading.
+		Skip(err error)
 	}
 
 	ReadJobResult interface {
 		Error() error
 	}
 )
-
-func ReadWorker(jobs <-chan ReadJob) {
-	for job := range jobs {
-		job.Read()
-	}
-}
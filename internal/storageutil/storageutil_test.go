@@ -13,9 +13,11 @@ import (
 
 	"github.com/fsouza/fake-gcs-server/fakestorage"
 	"github.com/getsentry/vroom/internal/sample"
+	"github.com/getsentry/vroom/internal/telemetry"
 	"github.com/google/uuid"
 	"github.com/phayes/freeport"
 	"github.com/pierrec/lz4/v4"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"gocloud.dev/blob"
 	_ "gocloud.dev/blob/fileblob"
 	_ "gocloud.dev/blob/gcsblob"
@@ -267,3 +269,72 @@ func BenchmarkJsonIterator(b *testing.B) {
 		}
 	}
 }
+
+func TestDownloadProfileCoalesced(t *testing.T) {
+	ctx := context.Background()
+	objectName := uuid.NewString()
+	originalData := []byte(`{"samples":[1,2],"frames":[3,4]}`)
+
+	var compressed bytes.Buffer
+	zw := lz4.NewWriter(&compressed)
+	if _, err := zw.Write(originalData); err != nil {
+		t.Fatalf("we should be able to compress the data: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("we should be able to compress the data: %v", err)
+	}
+
+	// Hold a read of the object in flight, the object doesn't exist in the
+	// bucket so readers only get data by joining it.
+	started := make(chan struct{})
+	release := make(chan struct{})
+	go reads.Do(fmt.Sprintf("%p/%s", fileBlobBucket, objectName), func() (interface{}, error) {
+		close(started)
+		<-release
+		return compressed.Bytes(), nil
+	})
+	<-started
+
+	const numReaders = 3
+	joined := make(chan struct{}, numReaders)
+	readJoined = func() { joined <- struct{}{} }
+	defer func() { readJoined = func() {} }()
+
+	coalesced := promtestutil.ToFloat64(telemetry.StorageReadsCoalesced)
+
+	profiles := make([]Profile, numReaders)
+	errs := make(chan error, numReaders)
+	for i := range profiles {
+		go func(p *Profile) {
+			errs <- UnmarshalCompressed(ctx, fileBlobBucket, objectName, p)
+		}(&profiles[i])
+	}
+	// Let the readers join the read before it completes.
+	for i := 0; i < numReaders; i++ {
+		<-joined
+	}
+	close(release)
+
+	for i := 0; i < numReaders; i++ {
+		if err := <-errs; err != nil {
+			t.Fatalf("we should be able to read the object: %v", err)
+		}
+	}
+	for _, p := range profiles {
+		b, err := json.Marshal(p)
+		if err != nil {
+			t.Fatalf("we should be able to marshal back to JSON: %v", err)
+		}
+		if !bytes.Equal(originalData, b) {
+			t.Fatalf("data should be identical: %v %v", string(originalData), string(b))
+		}
+	}
+	// Each reader decodes its own copy.
+	profiles[0].Samples[0] = 42
+	if profiles[1].Samples[0] == 42 {
+		t.Fatal("readers should not share decoded data")
+	}
+	if got := promtestutil.ToFloat64(telemetry.StorageReadsCoalesced) - coalesced; got != numReaders {
+		t.Fatalf("expected %d coalesced reads, got %v", numReaders, got)
+	}
+}
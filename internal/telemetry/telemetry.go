@@ -0,0 +1,186 @@
+// Package telemetry exposes service and pipeline metrics in the Prometheus
+// exposition format.
+package telemetry
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "vroom"
+
+var (
+	registry = prometheus.NewRegistry()
+
+	HTTPRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "Number of HTTP requests by route, method and status code.",
+	}, []string{"route", "method", "code"})
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of HTTP requests by route and method.",
+		Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"route", "method"})
+
+	GRPCRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_requests_total",
+		Help:      "Number of gRPC requests by method and status code.",
+	}, []string{"method", "code"})
+	GRPCRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_request_duration_seconds",
+		Help:      "Latency of gRPC requests by method.",
+		Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"method"})
+
+	ReadWorkers = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "read_workers",
+		Help:      "Number of storage read workers.",
+	})
+	ReadWorkersBusy = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "read_workers_busy",
+		Help:      "Number of storage read workers processing a job.",
+	})
+
+	StorageBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "storage_bytes_total",
+		Help:      "Compressed bytes read from or written to the storage.",
+	}, []string{"operation"})
+	StorageErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "storage_errors_total",
+		Help:      "Storage errors by operation and gcerrors code.",
+	}, []string{"operation", "code"})
+	StorageReadsCoalesced = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "storage_reads_coalesced_total",
+		Help:      "Storage reads served by an identical read already in flight.",
+	})
+
+	KafkaWriteErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "kafka_write_errors_total",
+		Help:      "Messages which failed to be written to Kafka by topic.",
+	}, []string{"topic"})
+
+	RateLimitedRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rate_limited_requests_total",
+		Help:      "Requests rejected for being over the rate limit of their organization by scope.",
+	}, []string{"scope"})
+
+	ProfilesIngested = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "profiles_ingested_total",
+		Help:      "Profiles ingested by platform.",
+	}, []string{"platform"})
+	OccurrencesEmitted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "occurrences_emitted_total",
+		Help:      "Occurrences sent to Kafka by category.",
+	}, []string{"category"})
+	OccurrencesSuppressed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "occurrences_suppressed_total",
+		Help:      "Occurrences dropped as duplicates by category.",
+	}, []string{"category"})
+)
+
+const (
+	StorageRead  = "read"
+	StorageWrite = "write"
+
+	RateLimitIngest = "ingest"
+	RateLimitRead   = "read"
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HTTPRequests,
+		HTTPRequestDuration,
+		GRPCRequests,
+		GRPCRequestDuration,
+		ReadWorkers,
+		ReadWorkersBusy,
+		StorageBytes,
+		StorageErrors,
+		StorageReadsCoalesced,
+		KafkaWriteErrors,
+		RateLimitedRequests,
+		ProfilesIngested,
+		OccurrencesEmitted,
+		OccurrencesSuppressed,
+	)
+}
+
+// Handler serves the metrics of the registry.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// RegisterGaugeFunc exposes a gauge computed when metrics are collected.
+func RegisterGaugeFunc(name, help string, f func() float64) {
+	registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      name,
+		Help:      help,
+	}, f))
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	code int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	if r.code == 0 {
+		r.code = code
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.code == 0 {
+		r.code = http.StatusOK
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// InstrumentRoute counts requests and measures their latency. route is the
+// route pattern to keep the cardinality low.
+func InstrumentRoute(route string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+		code := rec.code
+		if code == 0 {
+			code = http.StatusOK
+		}
+		HTTPRequests.WithLabelValues(route, r.Method, strconv.Itoa(code)).Inc()
+		HTTPRequestDuration.WithLabelValues(route, r.Method).Observe(time.Since(start).Seconds())
+	})
+}
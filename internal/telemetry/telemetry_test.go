@@ -0,0 +1,49 @@
+package telemetry
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestInstrumentRoute(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		code    string
+	}{
+		{
+			name: "explicit status code",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			},
+			code: "404",
+		},
+		{
+			name: "implicit status code on write",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("ok"))
+			},
+			code: "200",
+		},
+		{
+			name:    "no write",
+			handler: func(_ http.ResponseWriter, _ *http.Request) {},
+			code:    "200",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			route := "/test/" + test.name
+			h := InstrumentRoute(route, test.handler)
+			h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/test", nil))
+			got := testutil.ToFloat64(HTTPRequests.WithLabelValues(route, http.MethodGet, test.code))
+			if got != 1 {
+				t.Fatalf("expected 1 request with code %s, got %v", test.code, got)
+			}
+		})
+	}
+}
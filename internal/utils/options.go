@@ -1,11 +1,79 @@
 package utils
 
-import "encoding/json"
+import (
+	"encoding/json"
 
-type Options struct {
-	ProjectDSN string `json:"dsn"`
-}
+	"github.com/getsentry/vroom/internal/category"
+)
+
+type (
+	Options struct {
+		ProjectDSN string           `json:"dsn"`
+		Detection  DetectionOptions `json:"detection,omitempty"`
+	}
+
+	// DetectionOptions holds per-project overrides for the issue detectors.
+	// Categories are the ones defined in the category package.
+	DetectionOptions struct {
+		DisabledCategories   []string            `json:"disabled_categories,omitempty"`
+		DurationThresholdsMS map[string]uint64   `json:"duration_thresholds_ms,omitempty"`
+		SampleThresholds     map[string]int      `json:"sample_thresholds,omitempty"`
+		MutedFunctions       map[string][]string `json:"muted_functions,omitempty"`
+	}
+)
 
 func (o Options) MarshalJSON() ([]byte, error) {
 	return json.Marshal(nil)
 }
+
+// UnmarshalJSON also reads the app_start_threshold_ms key sent by clients
+// predating the detection options, the app_start threshold of the detection
+// options taking precedence.
+func (o *Options) UnmarshalJSON(b []byte) error {
+	type options Options
+	var raw struct {
+		options
+		AppStartThresholdMS uint64 `json:"app_start_threshold_ms"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	*o = Options(raw.options)
+	if raw.AppStartThresholdMS == 0 {
+		return nil
+	}
+	if _, exists := o.Detection.DurationThresholdsMS[string(category.AppStart)]; exists {
+		return nil
+	}
+	if o.Detection.DurationThresholdsMS == nil {
+		o.Detection.DurationThresholdsMS = make(map[string]uint64, 1)
+	}
+	o.Detection.DurationThresholdsMS[string(category.AppStart)] = raw.AppStartThresholdMS
+	return nil
+}
+
+// Merge returns the options with the overrides applied on top of them.
+// Thresholds from the overrides take precedence, disabled categories
+// and muted functions are added to the existing ones.
+func (o DetectionOptions) Merge(overrides DetectionOptions) DetectionOptions {
+	merged := DetectionOptions{
+		DisabledCategories:   make([]string, 0, len(o.DisabledCategories)+len(overrides.DisabledCategories)),
+		DurationThresholdsMS: make(map[string]uint64, len(o.DurationThresholdsMS)+len(overrides.DurationThresholdsMS)),
+		SampleThresholds:     make(map[string]int, len(o.SampleThresholds)+len(overrides.SampleThresholds)),
+		MutedFunctions:       make(map[string][]string, len(o.MutedFunctions)+len(overrides.MutedFunctions)),
+	}
+	merged.DisabledCategories = append(merged.DisabledCategories, o.DisabledCategories...)
+	merged.DisabledCategories = append(merged.DisabledCategories, overrides.DisabledCategories...)
+	for _, options := range []DetectionOptions{o, overrides} {
+		for c, threshold := range options.DurationThresholdsMS {
+			merged.DurationThresholdsMS[c] = threshold
+		}
+		for c, threshold := range options.SampleThresholds {
+			merged.SampleThresholds[c] = threshold
+		}
+		for pkg, functions := range options.MutedFunctions {
+			merged.MutedFunctions[pkg] = append(merged.MutedFunctions[pkg], functions...)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/getsentry/vroom/internal/testutil"
+)
+
+func TestOptionsUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want Options
+	}{
+		{
+			name: "detection options",
+			raw:  `{"dsn":"dsn","detection":{"duration_thresholds_ms":{"app_start":500}}}`,
+			want: Options{
+				ProjectDSN: "dsn",
+				Detection:  DetectionOptions{DurationThresholdsMS: map[string]uint64{"app_start": 500}},
+			},
+		},
+		{
+			name: "legacy app start threshold",
+			raw:  `{"dsn":"dsn","app_start_threshold_ms":3000}`,
+			want: Options{
+				ProjectDSN: "dsn",
+				Detection:  DetectionOptions{DurationThresholdsMS: map[string]uint64{"app_start": 3000}},
+			},
+		},
+		{
+			name: "detection options take precedence",
+			raw:  `{"app_start_threshold_ms":3000,"detection":{"duration_thresholds_ms":{"app_start":500}}}`,
+			want: Options{
+				Detection: DetectionOptions{DurationThresholdsMS: map[string]uint64{"app_start": 500}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var o Options
+			if err := json.Unmarshal([]byte(test.raw), &o); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := testutil.Diff(o, test.want); diff != "" {
+				t.Fatalf("Result mismatch: got - want +\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"github.com/getsentry/vroom/pkg/vroompb"
+)
+
+// FunctionMetricsProto converts function metrics to their gRPC
+// representation.
+func FunctionMetricsProto(metrics []FunctionMetrics) []*vroompb.FunctionMetrics {
+	pb := make([]*vroompb.FunctionMetrics, 0, len(metrics))
+	for _, m := range metrics {
+		pb = append(pb, m.Proto())
+	}
+	return pb
+}
+
+func (m FunctionMetrics) Proto() *vroompb.FunctionMetrics {
+	pb := &vroompb.FunctionMetrics{
+		Name:        m.Name,
+		Package:     m.Package,
+		Fingerprint: m.Fingerprint,
+		InApp:       m.InApp,
+		P75:         m.P75,
+		P95:         m.P95,
+		P99:         m.P99,
+		Avg:         m.Avg,
+		Sum:         m.Sum,
+		TotalSum:    m.TotalSum,
+		Count:       m.Count,
+		Worst:       m.Worst.Proto(),
+		Examples:    make([]*vroompb.Example, 0, len(m.Examples)),
+		Quantiles:   m.Quantiles,
+	}
+	for _, e := range m.Examples {
+		pb.Examples = append(pb.Examples, e.Proto())
+	}
+	if m.Sketch != nil {
+		s := m.Sketch.Serialize()
+		pb.Sketch = &vroompb.Sketch{
+			RelativeAccuracy: s.RelativeAccuracy,
+			Count:            s.Count,
+			Counts:           s.Counts,
+			Indexes:          s.Indexes,
+			Max:              s.Max,
+			Min:              s.Min,
+			Sum:              s.Sum,
+			ZeroCount:        s.ZeroCount,
+		}
+	}
+	for _, b := range m.Series {
+		pb.Series = append(pb.Series, &vroompb.FunctionMetricsBucket{
+			Start: b.Start,
+			P50:   b.P50,
+			P95:   b.P95,
+			Count: b.Count,
+		})
+	}
+	return pb
+}
+
+func (g FunctionMetricsGroup) Proto() *vroompb.FunctionMetricsGroup {
+	return &vroompb.FunctionMetricsGroup{
+		Dimensions:       g.Dimensions,
+		FunctionsMetrics: FunctionMetricsProto(g.FunctionsMetrics),
+	}
+}
+
+func (e ExampleMetadata) Proto() *vroompb.Example {
+	return &vroompb.Example{
+		ProjectId:     e.ProjectID,
+		ProfileId:     e.ProfileID,
+		ProfilerId:    e.ProfilerID,
+		ChunkId:       e.ChunkID,
+		TransactionId: e.TransactionID,
+		ThreadId:      e.ThreadID,
+		Start:         e.Start,
+		End:           e.End,
+	}
+}
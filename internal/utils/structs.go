@@ -1,5 +1,7 @@
 package utils
 
+import "github.com/getsentry/vroom/internal/sketch"
+
 type (
 	Interval struct {
 		Start          uint64 `json:"start,string"`
@@ -47,9 +49,30 @@ type (
 		P99         uint64            `json:"p99"`
 		Avg         float64           `json:"avg"`
 		Sum         uint64            `json:"sum"`
+		TotalSum    uint64            `json:"total_sum"`
 		Count       uint64            `json:"count"`
 		Worst       ExampleMetadata   `json:"worst"`
 		Examples    []ExampleMetadata `json:"examples"`
+		// Quantiles holds the requested quantiles keyed by their name (p50, p99.9).
+		Quantiles map[string]uint64 `json:"quantiles,omitempty"`
+		// Sketch is the serialized distribution of self times, mergeable with
+		// the ones returned by other calls.
+		Sketch *sketch.DDSketch `json:"sketch,omitempty"`
+		// Series holds the metrics over time when an interval is requested.
+		Series []FunctionMetricsBucket `json:"series,omitempty"`
+	}
+
+	FunctionMetricsBucket struct {
+		// Start is the unix timestamp of the beginning of the bucket.
+		Start int64  `json:"start"`
+		P50   uint64 `json:"p50"`
+		P95   uint64 `json:"p95"`
+		Count uint64 `json:"count"`
+	}
+
+	FunctionMetricsGroup struct {
+		Dimensions       map[string]string `json:"dimensions"`
+		FunctionsMetrics []FunctionMetrics `json:"functions_metrics"`
 	}
 )
 
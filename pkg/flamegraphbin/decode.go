@@ -0,0 +1,284 @@
+package flamegraphbin
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// maxPrealloc bounds the capacity allocated from a count read in the input
+// so a corrupted count can't exhaust memory before the input ends.
+const maxPrealloc = 4096
+
+type decoder struct {
+	r       *bufio.Reader
+	err     error
+	strings []string
+}
+
+// Decode reads a flamegraph in the binary format.
+func Decode(r io.Reader) (*Flamegraph, error) {
+	d := &decoder{r: bufio.NewReader(r)}
+	header := d.bytes(len(magic) + 1)
+	if d.err != nil {
+		return nil, d.err
+	}
+	if string(header[:len(magic)]) != magic {
+		return nil, fmt.Errorf("%w: bad magic", ErrInvalidFormat)
+	}
+	if header[len(magic)] != version {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidFormat, header[len(magic)])
+	}
+
+	n := d.count()
+	d.strings = make([]string, 0, min(n, maxPrealloc))
+	for i := 0; i < n && d.err == nil; i++ {
+		d.strings = append(d.strings, string(d.bytes(d.count())))
+	}
+
+	f := &Flamegraph{ProjectID: d.uvarint()}
+
+	n = d.count()
+	f.Frames = make([]Frame, 0, min(n, maxPrealloc))
+	for i := 0; i < n && d.err == nil; i++ {
+		fr := Frame{
+			Name:  d.string(),
+			File:  d.string(),
+			Path:  d.string(),
+			Image: d.string(),
+			Line:  uint32(d.uvarint()),
+			Col:   uint32(d.uvarint()),
+		}
+		flags := d.byte()
+		fr.IsApplication = flags&frameIsApplication != 0
+		fr.Inline = flags&frameInline != 0
+		f.Frames = append(f.Frames, fr)
+	}
+
+	n = d.count()
+	f.ProfileIDs = make([]string, 0, min(n, maxPrealloc))
+	for i := 0; i < n && d.err == nil; i++ {
+		f.ProfileIDs = append(f.ProfileIDs, d.string())
+	}
+
+	n = d.count()
+	f.Examples = make([]Example, 0, min(n, maxPrealloc))
+	for i := 0; i < n && d.err == nil; i++ {
+		ex := Example{
+			ProjectID:     d.uvarint(),
+			ProfileID:     d.string(),
+			ProfilerID:    d.string(),
+			ChunkID:       d.string(),
+			TransactionID: d.string(),
+		}
+		if threadID := d.uvarint(); threadID > 0 {
+			s := d.stringAt(threadID - 1)
+			ex.ThreadID = &s
+		}
+		ex.Start = d.float64()
+		ex.End = d.float64()
+		f.Examples = append(f.Examples, ex)
+	}
+
+	n = d.count()
+	f.Profiles = make([]Profile, 0, min(n, maxPrealloc))
+	for i := 0; i < n && d.err == nil; i++ {
+		f.Profiles = append(f.Profiles, d.profile())
+	}
+
+	if d.byte() != 0 {
+		f.Truncation = &Truncation{
+			PrunedSamples:   d.uvarint(),
+			PrunedFrames:    d.uvarint(),
+			TruncatedFrames: d.uvarint(),
+		}
+	}
+
+	if n := d.count(); n > 0 {
+		f.Metrics = d.bytes(n)
+	}
+
+	if d.err != nil {
+		return nil, d.err
+	}
+	return f, nil
+}
+
+func (d *decoder) profile() Profile {
+	p := Profile{
+		Name:     d.string(),
+		Unit:     d.string(),
+		ThreadID: d.uvarint(),
+	}
+	p.IsMainThread = d.byte()&profileIsMainThread != 0
+	p.StartValue = d.uvarint()
+	p.EndValue = d.uvarint()
+
+	n := d.count()
+	p.Samples = make([][]int, 0, min(n, maxPrealloc))
+	var previous []int
+	for i := 0; i < n && d.err == nil; i++ {
+		prefix := d.count()
+		if prefix > len(previous) {
+			d.fail("stack prefix longer than the previous stack")
+			break
+		}
+		last := 0
+		if prefix > 0 {
+			last = previous[prefix-1]
+		}
+		rest := d.deltas(last)
+		stack := make([]int, 0, prefix+len(rest))
+		stack = append(stack, previous[:prefix]...)
+		stack = append(stack, rest...)
+		p.Samples = append(p.Samples, stack)
+		previous = stack
+	}
+
+	columns := d.byte()
+	if columns&columnWeightsAreCounts == 0 {
+		p.Weights = d.uints()
+	}
+	if columns&columnDurations != 0 {
+		p.SampleDurationsNS = d.uints()
+	}
+	if columns&columnCounts != 0 {
+		p.SampleCounts = d.uints()
+	}
+	if columns&columnWeightsAreCounts != 0 {
+		p.Weights = append([]uint64(nil), p.SampleCounts...)
+	}
+	if columns&columnProfiles != 0 {
+		p.SamplesProfiles = d.lists()
+	}
+	if columns&columnExamples != 0 {
+		p.SamplesExamples = d.lists()
+	}
+	return p
+}
+
+func (d *decoder) deltas(last int) []int {
+	n := d.count()
+	values := make([]int, 0, min(n, maxPrealloc))
+	for i := 0; i < n && d.err == nil; i++ {
+		last += int(d.varint())
+		values = append(values, last)
+	}
+	return values
+}
+
+func (d *decoder) lists() [][]int {
+	n := d.count()
+	lists := make([][]int, 0, min(n, maxPrealloc))
+	for i := 0; i < n && d.err == nil; i++ {
+		lists = append(lists, d.deltas(0))
+	}
+	return lists
+}
+
+func (d *decoder) uints() []uint64 {
+	n := d.count()
+	values := make([]uint64, 0, min(n, maxPrealloc))
+	for i := 0; i < n && d.err == nil; i++ {
+		values = append(values, d.uvarint())
+	}
+	return values
+}
+
+func (d *decoder) fail(reason string) {
+	if d.err == nil {
+		d.err = fmt.Errorf("%w: %s", ErrInvalidFormat, reason)
+	}
+}
+
+func (d *decoder) count() int {
+	v := d.uvarint()
+	if v > math.MaxInt32 {
+		d.fail("count out of range")
+		return 0
+	}
+	return int(v)
+}
+
+func (d *decoder) string() string {
+	return d.stringAt(d.uvarint())
+}
+
+func (d *decoder) stringAt(i uint64) string {
+	if d.err != nil {
+		return ""
+	}
+	if i >= uint64(len(d.strings)) {
+		d.fail("string index out of range")
+		return ""
+	}
+	return d.strings[i]
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, err := binary.ReadUvarint(d.r)
+	if err != nil {
+		d.err = unexpectedEOF(err)
+	}
+	return v
+}
+
+func (d *decoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, err := binary.ReadVarint(d.r)
+	if err != nil {
+		d.err = unexpectedEOF(err)
+	}
+	return v
+}
+
+func (d *decoder) float64() float64 {
+	b := d.bytes(8)
+	if d.err != nil {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(b))
+}
+
+func (d *decoder) byte() byte {
+	if d.err != nil {
+		return 0
+	}
+	b, err := d.r.ReadByte()
+	if err != nil {
+		d.err = unexpectedEOF(err)
+	}
+	return b
+}
+
+func (d *decoder) bytes(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	b := make([]byte, 0, min(n, maxPrealloc))
+	for len(b) < n {
+		chunk := min(n-len(b), maxPrealloc)
+		start := len(b)
+		b = append(b, make([]byte, chunk)...)
+		if _, err := io.ReadFull(d.r, b[start:]); err != nil {
+			d.err = unexpectedEOF(err)
+			return nil
+		}
+	}
+	return b
+}
+
+// unexpectedEOF reports a truncated input as such.
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,259 @@
+package flamegraphbin
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+type encoder struct {
+	w       *bufio.Writer
+	err     error
+	buf     [binary.MaxVarintLen64]byte
+	strings map[string]uint64
+}
+
+// Encode writes the flamegraph in the binary format.
+func Encode(w io.Writer, f *Flamegraph) error {
+	e := &encoder{
+		w:       bufio.NewWriter(w),
+		strings: make(map[string]uint64),
+	}
+	e.bytes([]byte(magic))
+	e.byte(version)
+	e.stringTable(f)
+	e.uvarint(f.ProjectID)
+
+	e.uvarint(uint64(len(f.Frames)))
+	for _, fr := range f.Frames {
+		e.string(fr.Name)
+		e.string(fr.File)
+		e.string(fr.Path)
+		e.string(fr.Image)
+		e.uvarint(uint64(fr.Line))
+		e.uvarint(uint64(fr.Col))
+		var flags byte
+		if fr.IsApplication {
+			flags |= frameIsApplication
+		}
+		if fr.Inline {
+			flags |= frameInline
+		}
+		e.byte(flags)
+	}
+
+	e.uvarint(uint64(len(f.ProfileIDs)))
+	for _, id := range f.ProfileIDs {
+		e.string(id)
+	}
+
+	e.uvarint(uint64(len(f.Examples)))
+	for _, ex := range f.Examples {
+		e.uvarint(ex.ProjectID)
+		e.string(ex.ProfileID)
+		e.string(ex.ProfilerID)
+		e.string(ex.ChunkID)
+		e.string(ex.TransactionID)
+		if ex.ThreadID == nil {
+			e.uvarint(0)
+		} else {
+			e.uvarint(e.strings[*ex.ThreadID] + 1)
+		}
+		e.float64(ex.Start)
+		e.float64(ex.End)
+	}
+
+	e.uvarint(uint64(len(f.Profiles)))
+	for i := range f.Profiles {
+		e.profile(&f.Profiles[i])
+	}
+
+	if f.Truncation == nil {
+		e.byte(0)
+	} else {
+		e.byte(1)
+		e.uvarint(f.Truncation.PrunedSamples)
+		e.uvarint(f.Truncation.PrunedFrames)
+		e.uvarint(f.Truncation.TruncatedFrames)
+	}
+
+	e.uvarint(uint64(len(f.Metrics)))
+	e.bytes(f.Metrics)
+
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+// stringTable writes every string of the flamegraph once.
+func (e *encoder) stringTable(f *Flamegraph) {
+	var table []string
+	add := func(s string) {
+		if _, exists := e.strings[s]; exists {
+			return
+		}
+		e.strings[s] = uint64(len(table))
+		table = append(table, s)
+	}
+	for _, fr := range f.Frames {
+		add(fr.Name)
+		add(fr.File)
+		add(fr.Path)
+		add(fr.Image)
+	}
+	for _, id := range f.ProfileIDs {
+		add(id)
+	}
+	for _, ex := range f.Examples {
+		add(ex.ProfileID)
+		add(ex.ProfilerID)
+		add(ex.ChunkID)
+		add(ex.TransactionID)
+		if ex.ThreadID != nil {
+			add(*ex.ThreadID)
+		}
+	}
+	for _, p := range f.Profiles {
+		add(p.Name)
+		add(p.Unit)
+	}
+	e.uvarint(uint64(len(table)))
+	for _, s := range table {
+		e.uvarint(uint64(len(s)))
+		e.bytes([]byte(s))
+	}
+}
+
+func (e *encoder) profile(p *Profile) {
+	e.string(p.Name)
+	e.string(p.Unit)
+	e.uvarint(p.ThreadID)
+	var flags byte
+	if p.IsMainThread {
+		flags |= profileIsMainThread
+	}
+	e.byte(flags)
+	e.uvarint(p.StartValue)
+	e.uvarint(p.EndValue)
+
+	e.uvarint(uint64(len(p.Samples)))
+	var previous []int
+	for _, stack := range p.Samples {
+		prefix := 0
+		for prefix < len(stack) && prefix < len(previous) && stack[prefix] == previous[prefix] {
+			prefix++
+		}
+		e.uvarint(uint64(prefix))
+		last := 0
+		if prefix > 0 {
+			last = stack[prefix-1]
+		}
+		e.deltas(stack[prefix:], last)
+		previous = stack
+	}
+
+	var columns byte
+	if len(p.SampleCounts) > 0 && equalUints(p.Weights, p.SampleCounts) {
+		columns |= columnWeightsAreCounts
+	}
+	if len(p.SampleDurationsNS) > 0 {
+		columns |= columnDurations
+	}
+	if len(p.SampleCounts) > 0 {
+		columns |= columnCounts
+	}
+	if len(p.SamplesProfiles) > 0 {
+		columns |= columnProfiles
+	}
+	if len(p.SamplesExamples) > 0 {
+		columns |= columnExamples
+	}
+	e.byte(columns)
+
+	if columns&columnWeightsAreCounts == 0 {
+		e.uints(p.Weights)
+	}
+	if columns&columnDurations != 0 {
+		e.uints(p.SampleDurationsNS)
+	}
+	if columns&columnCounts != 0 {
+		e.uints(p.SampleCounts)
+	}
+	if columns&columnProfiles != 0 {
+		e.lists(p.SamplesProfiles)
+	}
+	if columns&columnExamples != 0 {
+		e.lists(p.SamplesExamples)
+	}
+}
+
+// deltas writes the number of values then each value as the difference with
+// the previous one.
+func (e *encoder) deltas(values []int, last int) {
+	e.uvarint(uint64(len(values)))
+	for _, v := range values {
+		e.varint(int64(v - last))
+		last = v
+	}
+}
+
+func (e *encoder) lists(lists [][]int) {
+	e.uvarint(uint64(len(lists)))
+	for _, l := range lists {
+		e.deltas(l, 0)
+	}
+}
+
+func (e *encoder) uints(values []uint64) {
+	e.uvarint(uint64(len(values)))
+	for _, v := range values {
+		e.uvarint(v)
+	}
+}
+
+func (e *encoder) string(s string) {
+	e.uvarint(e.strings[s])
+}
+
+func (e *encoder) uvarint(v uint64) {
+	n := binary.PutUvarint(e.buf[:], v)
+	e.bytes(e.buf[:n])
+}
+
+func (e *encoder) varint(v int64) {
+	n := binary.PutVarint(e.buf[:], v)
+	e.bytes(e.buf[:n])
+}
+
+func (e *encoder) float64(v float64) {
+	binary.LittleEndian.PutUint64(e.buf[:8], math.Float64bits(v))
+	e.bytes(e.buf[:8])
+}
+
+func (e *encoder) byte(b byte) {
+	if e.err != nil {
+		return
+	}
+	e.err = e.w.WriteByte(b)
+}
+
+func (e *encoder) bytes(b []byte) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = e.w.Write(b)
+}
+
+func equalUints(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,123 @@
+// Package flamegraphbin encodes and decodes the compact binary format of
+// aggregated flamegraphs served by vroom when requested with
+//
+//	Accept: application/x-vroom-flamegraph
+//
+// It holds the same data as the speedscope JSON format. Integers are
+// unsigned varints (signed ones are zigzag encoded) and strings are indices
+// in a string table. The layout is:
+//
+//	magic "VRFG", version byte
+//	string table: count, then length and bytes of each string
+//	project ID
+//	frame table: count, then name, file, path and image strings, line,
+//	  column and a flags byte (1: application, 2: inline) of each frame
+//	profile IDs: count, then a string each
+//	examples: count, then project ID, profile ID, profiler ID, chunk ID and
+//	  transaction ID strings, thread ID (0 if absent, string index + 1
+//	  otherwise), start and end as little endian float64 of each example
+//	profiles: count, then for each profile its name and unit strings, thread
+//	  ID, a flags byte (1: main thread), start and end values, the number of
+//	  samples, the stacks, a columns byte and the columns
+//	truncation: a presence byte then pruned samples, pruned frames and
+//	  truncated frames
+//	metrics: length then JSON of the function metrics, if any
+//
+// A stack is the length of the prefix it shares with the previous stack,
+// the number of remaining frames and the difference of each remaining frame
+// index with the previous frame of the stack. The columns byte tells which
+// optional columns follow the weights (2: sample durations, 4: sample
+// counts, 8: sample profiles, 16: sample examples). When 1 is set, weights
+// are equal to the sample counts and aren't repeated. Columns of integer
+// lists are encoded as a length and deltas, like stacks without a prefix.
+package flamegraphbin
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ContentType is the media type of the format.
+const ContentType = "application/x-vroom-flamegraph"
+
+const (
+	magic   = "VRFG"
+	version = 1
+)
+
+const (
+	frameIsApplication = 1 << iota
+	frameInline
+)
+
+const profileIsMainThread = 1
+
+const (
+	columnWeightsAreCounts = 1 << iota
+	columnDurations
+	columnCounts
+	columnProfiles
+	columnExamples
+)
+
+var ErrInvalidFormat = errors.New("flamegraphbin: invalid format")
+
+type (
+	Flamegraph struct {
+		ProjectID  uint64
+		Frames     []Frame
+		ProfileIDs []string
+		Examples   []Example
+		Profiles   []Profile
+		Truncation *Truncation
+		// Metrics holds the JSON of the function metrics, if any.
+		Metrics json.RawMessage
+	}
+
+	Frame struct {
+		Name          string
+		File          string
+		Path          string
+		Image         string
+		Line          uint32
+		Col           uint32
+		IsApplication bool
+		Inline        bool
+	}
+
+	// Example identifies a profile or a chunk a sample was found in.
+	Example struct {
+		ProjectID     uint64
+		ProfileID     string
+		ProfilerID    string
+		ChunkID       string
+		TransactionID string
+		ThreadID      *string
+		Start         float64
+		End           float64
+	}
+
+	Profile struct {
+		Name         string
+		Unit         string
+		ThreadID     uint64
+		IsMainThread bool
+		StartValue   uint64
+		EndValue     uint64
+		// Samples are stacks of indices in the frame table.
+		Samples           [][]int
+		Weights           []uint64
+		SampleDurationsNS []uint64
+		SampleCounts      []uint64
+		// SamplesProfiles are indices in the profile IDs and SamplesExamples
+		// indices in the examples.
+		SamplesProfiles [][]int
+		SamplesExamples [][]int
+	}
+
+	Truncation struct {
+		PrunedSamples   uint64
+		PrunedFrames    uint64
+		TruncatedFrames uint64
+	}
+)
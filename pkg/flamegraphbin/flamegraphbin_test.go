@@ -0,0 +1,102 @@
+package flamegraphbin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func testFlamegraph() *Flamegraph {
+	threadID := "259"
+	return &Flamegraph{
+		ProjectID: 1,
+		Frames: []Frame{
+			{Name: "main", File: "main.py", Path: "/app/main.py", Line: 10, IsApplication: true},
+			{Name: "run", File: "main.py", Path: "/app/main.py", Line: 20, IsApplication: true},
+			{Name: "read", Image: "libc.so", Col: 3, Inline: true},
+		},
+		ProfileIDs: []string{"a", "b"},
+		Examples: []Example{
+			{ProjectID: 1, ProfilerID: "p", ChunkID: "c", ThreadID: &threadID, Start: 1.5, End: 2.25},
+			{ProjectID: 2, ProfileID: "a", TransactionID: "t"},
+		},
+		Profiles: []Profile{
+			{
+				Name:              "main",
+				Unit:              "count",
+				IsMainThread:      true,
+				EndValue:          12,
+				Samples:           [][]int{{0, 1}, {0, 1, 2}, {0, 2}, {}, {2, 0}},
+				Weights:           []uint64{3, 4, 1, 1, 3},
+				SampleCounts:      []uint64{3, 4, 1, 1, 3},
+				SampleDurationsNS: []uint64{30, 40, 10, 10, 30},
+				SamplesProfiles:   [][]int{{1, 0}, {0}, {}, {1}, {0, 1}},
+				SamplesExamples:   [][]int{{0}, {1, 0}, {}, {}, {1}},
+			},
+			{
+				Name:     "worker",
+				Unit:     "nanoseconds",
+				ThreadID: 259,
+				Samples:  [][]int{{1}},
+				Weights:  []uint64{7},
+			},
+		},
+		Truncation: &Truncation{PrunedSamples: 1, PrunedFrames: 2, TruncatedFrames: 3},
+		Metrics:    json.RawMessage(`[{"name":"main"}]`),
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	tests := []struct {
+		name       string
+		flamegraph *Flamegraph
+	}{
+		{
+			name:       "empty",
+			flamegraph: &Flamegraph{},
+		},
+		{
+			name:       "aggregated flamegraph",
+			flamegraph: testFlamegraph(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := Encode(&b, test.flamegraph); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			decoded, err := Decode(&b)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.flamegraph, decoded, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("Result mismatch: got - want +\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	var b bytes.Buffer
+	if err := Encode(&b, testFlamegraph()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	encoded := b.Bytes()
+
+	if _, err := Decode(bytes.NewReader([]byte("JSON{}"))); !errors.Is(err, ErrInvalidFormat) {
+		t.Fatalf("expected ErrInvalidFormat, got %v", err)
+	}
+	for i := 0; i < len(encoded); i++ {
+		_, err := Decode(bytes.NewReader(encoded[:i]))
+		if !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, ErrInvalidFormat) {
+			t.Fatalf("expected an error decoding %d bytes, got %v", i, err)
+		}
+	}
+}
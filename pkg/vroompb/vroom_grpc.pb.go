@@ -0,0 +1,314 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: pkg/vroompb/vroom.proto
+
+// The gRPC API mirrors the HTTP read endpoints for services which don't want
+// to decode large JSON payloads. Regenerate the Go code with `make proto`.
+//
+// When authentication is enabled, calls are signed like HTTP requests and
+// carry the x-vroom-key-id, x-vroom-timestamp and x-vroom-signature metadata.
+// The signature is the hex encoded HMAC-SHA256 of "POST", the full method
+// name, the timestamp and the hex encoded SHA-256 of the request message,
+// separated by new lines. The message is hashed as serialized on the wire,
+// clients have to hash the bytes they send, not a re-serialization of them
+// since serializations vary between implementations.
+
+package vroompb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Vroom_GetProfile_FullMethodName       = "/vroom.v1.Vroom/GetProfile"
+	Vroom_GetChunksProfile_FullMethodName = "/vroom.v1.Vroom/GetChunksProfile"
+	Vroom_GetFlamegraph_FullMethodName    = "/vroom.v1.Vroom/GetFlamegraph"
+	Vroom_GetMetrics_FullMethodName       = "/vroom.v1.Vroom/GetMetrics"
+	Vroom_ProcessRegressed_FullMethodName = "/vroom.v1.Vroom/ProcessRegressed"
+)
+
+// VroomClient is the client API for Vroom service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VroomClient interface {
+	// GetProfile returns a profile in the speedscope format.
+	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*Speedscope, error)
+	// GetChunksProfile merges chunks of a profiler into a single one covering
+	// a time range.
+	GetChunksProfile(ctx context.Context, in *GetChunksProfileRequest, opts ...grpc.CallOption) (*Chunk, error)
+	// GetFlamegraph aggregates transaction and continuous profiles into a
+	// flamegraph. The first message holds the header, the next ones the
+	// samples of each profile.
+	GetFlamegraph(ctx context.Context, in *GetFlamegraphRequest, opts ...grpc.CallOption) (Vroom_GetFlamegraphClient, error)
+	// GetMetrics computes the metrics of the functions of transaction and
+	// continuous profiles.
+	GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error)
+	// ProcessRegressed sends occurrences for regressed functions.
+	ProcessRegressed(ctx context.Context, in *ProcessRegressedRequest, opts ...grpc.CallOption) (*ProcessRegressedResponse, error)
+}
+
+type vroomClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVroomClient(cc grpc.ClientConnInterface) VroomClient {
+	return &vroomClient{cc}
+}
+
+func (c *vroomClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*Speedscope, error) {
+	out := new(Speedscope)
+	err := c.cc.Invoke(ctx, Vroom_GetProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vroomClient) GetChunksProfile(ctx context.Context, in *GetChunksProfileRequest, opts ...grpc.CallOption) (*Chunk, error) {
+	out := new(Chunk)
+	err := c.cc.Invoke(ctx, Vroom_GetChunksProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vroomClient) GetFlamegraph(ctx context.Context, in *GetFlamegraphRequest, opts ...grpc.CallOption) (Vroom_GetFlamegraphClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Vroom_ServiceDesc.Streams[0], Vroom_GetFlamegraph_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vroomGetFlamegraphClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vroom_GetFlamegraphClient interface {
+	Recv() (*FlamegraphPart, error)
+	grpc.ClientStream
+}
+
+type vroomGetFlamegraphClient struct {
+	grpc.ClientStream
+}
+
+func (x *vroomGetFlamegraphClient) Recv() (*FlamegraphPart, error) {
+	m := new(FlamegraphPart)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vroomClient) GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error) {
+	out := new(GetMetricsResponse)
+	err := c.cc.Invoke(ctx, Vroom_GetMetrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vroomClient) ProcessRegressed(ctx context.Context, in *ProcessRegressedRequest, opts ...grpc.CallOption) (*ProcessRegressedResponse, error) {
+	out := new(ProcessRegressedResponse)
+	err := c.cc.Invoke(ctx, Vroom_ProcessRegressed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VroomServer is the server API for Vroom service.
+// All implementations must embed UnimplementedVroomServer
+// for forward compatibility
+type VroomServer interface {
+	// GetProfile returns a profile in the speedscope format.
+	GetProfile(context.Context, *GetProfileRequest) (*Speedscope, error)
+	// GetChunksProfile merges chunks of a profiler into a single one covering
+	// a time range.
+	GetChunksProfile(context.Context, *GetChunksProfileRequest) (*Chunk, error)
+	// GetFlamegraph aggregates transaction and continuous profiles into a
+	// flamegraph. The first message holds the header, the next ones the
+	// samples of each profile.
+	GetFlamegraph(*GetFlamegraphRequest, Vroom_GetFlamegraphServer) error
+	// GetMetrics computes the metrics of the functions of transaction and
+	// continuous profiles.
+	GetMetrics(context.Context, *GetMetricsRequest) (*GetMetricsResponse, error)
+	// ProcessRegressed sends occurrences for regressed functions.
+	ProcessRegressed(context.Context, *ProcessRegressedRequest) (*ProcessRegressedResponse, error)
+	mustEmbedUnimplementedVroomServer()
+}
+
+// UnimplementedVroomServer must be embedded to have forward compatible implementations.
+type UnimplementedVroomServer struct {
+}
+
+func (UnimplementedVroomServer) GetProfile(context.Context, *GetProfileRequest) (*Speedscope, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (UnimplementedVroomServer) GetChunksProfile(context.Context, *GetChunksProfileRequest) (*Chunk, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChunksProfile not implemented")
+}
+func (UnimplementedVroomServer) GetFlamegraph(*GetFlamegraphRequest, Vroom_GetFlamegraphServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetFlamegraph not implemented")
+}
+func (UnimplementedVroomServer) GetMetrics(context.Context, *GetMetricsRequest) (*GetMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+}
+func (UnimplementedVroomServer) ProcessRegressed(context.Context, *ProcessRegressedRequest) (*ProcessRegressedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessRegressed not implemented")
+}
+func (UnimplementedVroomServer) mustEmbedUnimplementedVroomServer() {}
+
+// UnsafeVroomServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VroomServer will
+// result in compilation errors.
+type UnsafeVroomServer interface {
+	mustEmbedUnimplementedVroomServer()
+}
+
+func RegisterVroomServer(s grpc.ServiceRegistrar, srv VroomServer) {
+	s.RegisterService(&Vroom_ServiceDesc, srv)
+}
+
+func _Vroom_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VroomServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vroom_GetProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VroomServer).GetProfile(ctx, req.(*GetProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vroom_GetChunksProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChunksProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VroomServer).GetChunksProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vroom_GetChunksProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VroomServer).GetChunksProfile(ctx, req.(*GetChunksProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vroom_GetFlamegraph_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFlamegraphRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VroomServer).GetFlamegraph(m, &vroomGetFlamegraphServer{stream})
+}
+
+type Vroom_GetFlamegraphServer interface {
+	Send(*FlamegraphPart) error
+	grpc.ServerStream
+}
+
+type vroomGetFlamegraphServer struct {
+	grpc.ServerStream
+}
+
+func (x *vroomGetFlamegraphServer) Send(m *FlamegraphPart) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vroom_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VroomServer).GetMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vroom_GetMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VroomServer).GetMetrics(ctx, req.(*GetMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vroom_ProcessRegressed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessRegressedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VroomServer).ProcessRegressed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vroom_ProcessRegressed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VroomServer).ProcessRegressed(ctx, req.(*ProcessRegressedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Vroom_ServiceDesc is the grpc.ServiceDesc for Vroom service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Vroom_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vroom.v1.Vroom",
+	HandlerType: (*VroomServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProfile",
+			Handler:    _Vroom_GetProfile_Handler,
+		},
+		{
+			MethodName: "GetChunksProfile",
+			Handler:    _Vroom_GetChunksProfile_Handler,
+		},
+		{
+			MethodName: "GetMetrics",
+			Handler:    _Vroom_GetMetrics_Handler,
+		},
+		{
+			MethodName: "ProcessRegressed",
+			Handler:    _Vroom_ProcessRegressed_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetFlamegraph",
+			Handler:       _Vroom_GetFlamegraph_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/vroompb/vroom.proto",
+}